@@ -0,0 +1,189 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Values longer than that are truncated in error messages.
+const valueLimit = 100
+
+// Assertion on a value in the JSON response, all the set conditions should hold.
+type assertion struct {
+	Path    string      // Like queue.lag or workers[0].state
+	Equals  interface{} // String, number or boolean.
+	Match   string
+	Less    *float64
+	Greater *float64
+	Exists  *bool
+	steps   []interface{} // Object keys and array indexes.
+	regex   *regexp.Regexp
+}
+
+// Check the assertion and parse its path.
+func (rule *assertion) validate() (err error) {
+	if rule.steps, err = parsePath(rule.Path); err != nil {
+		return
+	}
+	switch rule.Equals.(type) {
+	case nil, string, bool, int, float64:
+	default:
+		return errors.New(rule.Path + ": equals should be a string, number or boolean")
+	}
+	if rule.Equals == nil && rule.Match == "" && rule.Less == nil && rule.Greater == nil && rule.Exists == nil {
+		return errors.New(rule.Path + ": no equals, match, less, greater or exists")
+	}
+	if rule.Match != "" {
+		rule.regex, err = regexp.Compile(rule.Match)
+	}
+	return
+}
+
+// Split the path into object keys and array indexes: a.b[0].c
+func parsePath(path string) (steps []interface{}, err error) {
+	if path == "" {
+		return nil, errors.New("assertion with no path")
+	}
+	if path == "$" { // The whole document.
+		return
+	}
+	for _, part := range strings.Split(strings.TrimPrefix(path, "$."), ".") {
+		key := part
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			key = part[:i]
+			part = part[i:]
+		} else {
+			part = ""
+		}
+		if key != "" {
+			steps = append(steps, key)
+		} else if part == "" {
+			return nil, errors.New("invalid path " + path + ": empty key")
+		}
+		for part != "" {
+			end := strings.IndexByte(part, ']')
+			if part[0] != '[' || end < 0 {
+				return nil, errors.New("invalid path " + path)
+			}
+			index, err := strconv.Atoi(part[1:end])
+			if err != nil || index < 0 {
+				return nil, errors.New("invalid path " + path + ": bad index " + part[1:end])
+			}
+			steps = append(steps, index)
+			part = part[end+1:]
+		}
+	}
+	return
+}
+
+// Find the value by the path.
+func lookup(doc interface{}, steps []interface{}) (interface{}, bool) {
+	for _, step := range steps {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			key, ok := step.(string)
+			if !ok {
+				return nil, false
+			}
+			if doc, ok = node[key]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			index, ok := step.(int)
+			if !ok { // Also allow workers.0.state
+				var err error
+				if index, err = strconv.Atoi(step.(string)); err != nil {
+					return nil, false
+				}
+			}
+			if index >= len(node) {
+				return nil, false
+			}
+			doc = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// Check the JSON body, the error tells the failed assertion and the actual value.
+func assertJSON(body []byte, rules []*assertion) error {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return errors.New("invalid JSON: " + err.Error())
+	}
+	for _, rule := range rules {
+		if err := rule.check(doc); err != nil {
+			return errors.New(rule.Path + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+func (rule *assertion) check(doc interface{}) error {
+	value, found := lookup(doc, rule.steps)
+	if rule.Exists != nil && found != *rule.Exists {
+		if found {
+			return errors.New("expected not to exist, got " + describeValue(value))
+		}
+		return errors.New("expected to exist")
+	}
+	if !found {
+		if rule.Exists != nil { // Asserted not to exist.
+			return nil
+		}
+		return errors.New("not found")
+	}
+	if rule.Equals != nil && !equal(value, rule.Equals) {
+		expected, _ := json.Marshal(rule.Equals)
+		return errors.New("expected " + string(expected) + ", got " + describeValue(value))
+	}
+	if rule.regex != nil {
+		text, ok := value.(string)
+		if !ok {
+			text = describeValue(value)
+		}
+		if !rule.regex.MatchString(text) {
+			return errors.New("expected to match " + rule.Match + ", got " + describeValue(value))
+		}
+	}
+	if rule.Less == nil && rule.Greater == nil {
+		return nil
+	}
+	number, ok := value.(float64)
+	if !ok {
+		return errors.New("expected a number, got " + describeValue(value))
+	}
+	if rule.Less != nil && !(number < *rule.Less) {
+		return errors.New("expected < " + formatNumber(*rule.Less) + ", got " + describeValue(value))
+	}
+	if rule.Greater != nil && !(number > *rule.Greater) {
+		return errors.New("expected > " + formatNumber(*rule.Greater) + ", got " + describeValue(value))
+	}
+	return nil
+}
+
+// Compare the JSON value with the one from the config.
+func equal(value interface{}, expected interface{}) bool {
+	switch expected := expected.(type) {
+	case int:
+		return value == float64(expected)
+	case float64, string, bool:
+		return value == expected
+	}
+	return false
+}
+
+// The value as JSON, truncated.
+func describeValue(value interface{}) string {
+	text, _ := json.Marshal(value)
+	return truncate(string(text), valueLimit)
+}
+
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
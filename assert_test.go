@@ -0,0 +1,89 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	for _, test := range []struct {
+		path  string
+		steps []interface{}
+	}{
+		{"$", nil},
+		{"status", []interface{}{"status"}},
+		{"$.queue.lag", []interface{}{"queue", "lag"}},
+		{"workers[0].state", []interface{}{"workers", 0, "state"}},
+		{"matrix[1][2]", []interface{}{"matrix", 1, 2}},
+		{"[3]", []interface{}{3}},
+	} {
+		steps, err := parsePath(test.path)
+		if err != nil {
+			t.Errorf("%s: %v", test.path, err)
+		} else if !reflect.DeepEqual(steps, test.steps) {
+			t.Errorf("%s: expected %v, got %v", test.path, test.steps, steps)
+		}
+	}
+	for _, path := range []string{"", "a..b", "a[", "a[x]", "a[-1]", "a[0]b"} {
+		if _, err := parsePath(path); err == nil {
+			t.Errorf("%q: expected an error", path)
+		}
+	}
+}
+
+func TestAssertJSON(t *testing.T) {
+	body := []byte(`{"status": "ok", "queue": {"lag": 5}, "workers": [{"state": "idle"}, {"state": "busy"}],
+		"ready": true, "error": null}`)
+	less, greater := 10.0, 5.0
+	yes, no := true, false
+	for _, test := range []struct {
+		rule *assertion
+		err  string
+	}{
+		{&assertion{Path: "status", Equals: "ok"}, ""},
+		{&assertion{Path: "status", Equals: "down"}, `status: expected "down", got "ok"`},
+		{&assertion{Path: "queue.lag", Equals: 5}, ""},
+		{&assertion{Path: "queue.lag", Equals: 5.5}, "queue.lag: expected 5.5, got 5"},
+		{&assertion{Path: "ready", Equals: true}, ""},
+		{&assertion{Path: "workers[1].state", Match: "^bu"}, ""},
+		{&assertion{Path: "workers.0.state", Equals: "idle"}, ""},
+		{&assertion{Path: "workers[0].state", Match: "^bu"}, `workers[0].state: expected to match ^bu, got "idle"`},
+		{&assertion{Path: "queue.lag", Less: &less}, ""},
+		{&assertion{Path: "queue.lag", Greater: &greater}, "queue.lag: expected > 5, got 5"},
+		{&assertion{Path: "status", Less: &less}, `status: expected a number, got "ok"`},
+		{&assertion{Path: "error", Exists: &yes}, ""},
+		{&assertion{Path: "queue.size", Exists: &no}, ""},
+		{&assertion{Path: "queue", Exists: &no}, `queue: expected not to exist, got {"lag":5}`},
+		{&assertion{Path: "workers[2]", Exists: &yes}, "workers[2]: expected to exist"},
+		{&assertion{Path: "queue.size", Equals: 0}, "queue.size: not found"},
+		{&assertion{Path: "status.code", Equals: 0}, "status.code: not found"},
+	} {
+		if err := test.rule.validate(); err != nil {
+			t.Errorf("%s: %v", test.rule.Path, err)
+			continue
+		}
+		err := assertJSON(body, []*assertion{test.rule})
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.rule.Path, err)
+		case test.err != "" && (err == nil || err.Error() != test.err):
+			t.Errorf("%s: expected error %q, got %v", test.rule.Path, test.err, err)
+		}
+	}
+	if err := assertJSON([]byte("<html>"), nil); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestAssertionValidate(t *testing.T) {
+	for _, rule := range []*assertion{
+		{Path: "status"},
+		{Path: "status", Equals: []interface{}{"ok"}},
+		{Path: "status", Match: "("},
+		{Equals: "ok"},
+	} {
+		if err := rule.validate(); err == nil {
+			t.Errorf("%+v: expected an error", rule)
+		}
+	}
+}
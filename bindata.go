@@ -90,7 +90,7 @@ func angularMinJs() (*asset, error) {
 	return a, nil
 }
 
-var _appJs = "\x27\x75\x73\x65\x20\x73\x74\x72\x69\x63\x74\x27\x3b\x0a\x0a\x76\x61\x72\x20\x41\x70\x70\x20\x20\x20\x3d\x20\x61\x6e\x67\x75\x6c\x61\x72\x2e\x6d\x6f\x64\x75\x6c\x65\x28\x27\x6a\x73\x6f\x6e\x6d\x6f\x6e\x27\x2c\x20\x5b\x5d\x29\x2c\x0a\x20\x20\x20\x20\x54\x69\x74\x6c\x65\x20\x3d\x20\x27\x53\x79\x73\x74\x65\x6d\x73\x20\x73\x74\x61\x74\x75\x73\x27\x3b\x0a\x0a\x41\x70\x70\x2e\x63\x6f\x6e\x66\x69\x67\x28\x5b\x27\x24\x63\x6f\x6d\x70\x69\x6c\x65\x50\x72\x6f\x76\x69\x64\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x24\x63\x6f\x6d\x70\x69\x6c\x65\x50\x72\x6f\x76\x69\x64\x65\x72\x29\x20\x7b\x0a\x20\x20\x24\x63\x6f\x6d\x70\x69\x6c\x65\x50\x72\x6f\x76\x69\x64\x65\x72\x2e\x64\x65\x62\x75\x67\x49\x6e\x66\x6f\x45\x6e\x61\x62\x6c\x65\x64\x28\x66\x61\x6c\x73\x65\x29\x3b\x0a\x7d\x5d\x29\x3b\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x65\x74\x4a\x73\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x20\x7b\x0a\x20\x20\x24\x68\x74\x74\x70\x2e\x67\x65\x74\x28\x27\x2f\x73\x74\x61\x74\x75\x73\x27\x29\x0a\x20\x20\x20\x20\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x29\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x61\x6e\x67\x75\x6c\x61\x72\x2e\x65\x71\x75\x61\x6c\x73\x28\x24\x73\x63\x6f\x70\x65\x2e\x6a\x73\x6f\x6e\x2c\x20\x72\x65\x73\x2e\x64\x61\x74\x61\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x6a\x73\x6f\x6e\x20\x3d\x20\x72\x65\x73\x2e\x64\x61\x74\x61\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x50\x61\x67\x65\x20\x74\x69\x74\x6c\x65\x20\x73\x68\x6f\x75\x6c\x64\x20\x69\x6e\x63\x6c\x75\x64\x65\x20\x65\x72\x72\x6f\x72\x73\x20\x6e\x75\x6d\x62\x65\x72\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x72\x72\x6f\x72\x73\x20\x3d\x20\x72\x65\x73\x2e\x64\x61\x74\x61\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x68\x65\x63\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x63\x68\x65\x63\x6b\x2e\x66\x61\x69\x6c\x65\x64\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x65\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2e\x74\x69\x74\x6c\x65\x20\x3d\x20\x27\x28\x27\x20\x2b\x20\x65\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x2b\x20\x27\x29\x20\x27\x20\x2b\x20\x54\x69\x74\x6c\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2e\x74\x69\x74\x6c\x65\x20\x3d\x20\x54\x69\x74\x6c\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x7d\x0a\x0a\x41\x70\x70\x2e\x63\x6f\x6e\x74\x72\x6f\x6c\x6c\x65\x72\x28\x27\x72\x65\x6c\x6f\x61\x64\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x20\x7b\x0a\x20\x20\x67\x65\x74\x4a\x73\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x3b\x0a\x20\x20\x73\x65\x74\x49\x6e\x74\x65\x72\x76\x61\x6c\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x67\x65\x74\x4a\x73\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x3b\x0a\x20\x20\x7d\x2c\x20\x35\x20\x2a\x20\x31\x30\x30\x30\x29\x3b\x0a\x7d\x29\x3b\x0a"
+var _appJs = "\x27\x75\x73\x65\x20\x73\x74\x72\x69\x63\x74\x27\x3b\x0a\x0a\x76\x61\x72\x20\x41\x70\x70\x20\x20\x20\x3d\x20\x61\x6e\x67\x75\x6c\x61\x72\x2e\x6d\x6f\x64\x75\x6c\x65\x28\x27\x6a\x73\x6f\x6e\x6d\x6f\x6e\x27\x2c\x20\x5b\x5d\x29\x2c\x0a\x20\x20\x20\x20\x54\x69\x74\x6c\x65\x20\x3d\x20\x27\x53\x79\x73\x74\x65\x6d\x73\x20\x73\x74\x61\x74\x75\x73\x27\x3b\x0a\x0a\x41\x70\x70\x2e\x63\x6f\x6e\x66\x69\x67\x28\x5b\x27\x24\x63\x6f\x6d\x70\x69\x6c\x65\x50\x72\x6f\x76\x69\x64\x65\x72\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x24\x63\x6f\x6d\x70\x69\x6c\x65\x50\x72\x6f\x76\x69\x64\x65\x72\x29\x20\x7b\x0a\x20\x20\x24\x63\x6f\x6d\x70\x69\x6c\x65\x50\x72\x6f\x76\x69\x64\x65\x72\x2e\x64\x65\x62\x75\x67\x49\x6e\x66\x6f\x45\x6e\x61\x62\x6c\x65\x64\x28\x66\x61\x6c\x73\x65\x29\x3b\x0a\x7d\x5d\x29\x3b\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x65\x74\x4a\x73\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x20\x7b\x0a\x20\x20\x24\x68\x74\x74\x70\x2e\x67\x65\x74\x28\x27\x2f\x73\x74\x61\x74\x75\x73\x27\x29\x0a\x20\x20\x20\x20\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x29\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x61\x6e\x67\x75\x6c\x61\x72\x2e\x65\x71\x75\x61\x6c\x73\x28\x24\x73\x63\x6f\x70\x65\x2e\x6a\x73\x6f\x6e\x2c\x20\x72\x65\x73\x2e\x64\x61\x74\x61\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x6a\x73\x6f\x6e\x20\x3d\x20\x72\x65\x73\x2e\x64\x61\x74\x61\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x67\x72\x6f\x75\x70\x73\x20\x3d\x20\x67\x72\x6f\x75\x70\x28\x72\x65\x73\x2e\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x50\x61\x67\x65\x20\x74\x69\x74\x6c\x65\x20\x73\x68\x6f\x75\x6c\x64\x20\x69\x6e\x63\x6c\x75\x64\x65\x20\x65\x72\x72\x6f\x72\x73\x20\x6e\x75\x6d\x62\x65\x72\x2c\x20\x70\x65\x72\x20\x67\x72\x6f\x75\x70\x20\x69\x66\x20\x67\x72\x6f\x75\x70\x65\x64\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x72\x72\x6f\x72\x73\x20\x3d\x20\x24\x73\x63\x6f\x70\x65\x2e\x67\x72\x6f\x75\x70\x73\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x67\x72\x6f\x75\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x67\x72\x6f\x75\x70\x2e\x66\x61\x69\x6c\x65\x64\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x67\x72\x6f\x75\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x20\x3f\x20\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x20\x2b\x20\x27\x3a\x20\x27\x20\x2b\x20\x67\x72\x6f\x75\x70\x2e\x66\x61\x69\x6c\x65\x64\x20\x3a\x20\x67\x72\x6f\x75\x70\x2e\x66\x61\x69\x6c\x65\x64\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x65\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2e\x74\x69\x74\x6c\x65\x20\x3d\x20\x27\x28\x27\x20\x2b\x20\x65\x72\x72\x6f\x72\x73\x2e\x6a\x6f\x69\x6e\x28\x27\x2c\x20\x27\x29\x20\x2b\x20\x27\x29\x20\x27\x20\x2b\x20\x54\x69\x74\x6c\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2e\x74\x69\x74\x6c\x65\x20\x3d\x20\x54\x69\x74\x6c\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x4b\x65\x65\x70\x20\x74\x68\x65\x20\x6f\x70\x65\x6e\x65\x64\x20\x64\x65\x74\x61\x69\x6c\x73\x20\x75\x70\x20\x74\x6f\x20\x64\x61\x74\x65\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x4f\x62\x6a\x65\x63\x74\x2e\x6b\x65\x79\x73\x28\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x29\x2e\x66\x6f\x72\x45\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x69\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x67\x65\x74\x44\x65\x74\x61\x69\x6c\x28\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x2c\x20\x69\x64\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x43\x68\x65\x63\x6b\x27\x73\x20\x6c\x61\x73\x74\x20\x66\x61\x69\x6c\x75\x72\x65\x3a\x20\x65\x72\x72\x6f\x72\x2c\x20\x6f\x75\x74\x70\x75\x74\x2c\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x2e\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x65\x74\x44\x65\x74\x61\x69\x6c\x28\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x2c\x20\x69\x64\x29\x20\x7b\x0a\x20\x20\x24\x68\x74\x74\x70\x2e\x67\x65\x74\x28\x27\x2f\x73\x74\x61\x74\x75\x73\x2f\x27\x20\x2b\x20\x69\x64\x29\x0a\x20\x20\x20\x20\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x72\x65\x73\x29\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x69\x64\x20\x69\x6e\x20\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x5b\x69\x64\x5d\x20\x3d\x20\x72\x65\x73\x2e\x64\x61\x74\x61\x2e\x6c\x61\x73\x74\x5f\x65\x72\x72\x6f\x72\x20\x7c\x7c\x20\x72\x65\x73\x2e\x64\x61\x74\x61\x2e\x6c\x61\x73\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x20\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x5b\x69\x64\x5d\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x53\x70\x6c\x69\x74\x20\x63\x68\x65\x63\x6b\x73\x20\x69\x6e\x74\x6f\x20\x67\x72\x6f\x75\x70\x73\x2c\x20\x6b\x65\x65\x70\x69\x6e\x67\x20\x74\x68\x65\x20\x63\x6f\x6e\x66\x69\x67\x20\x6f\x72\x64\x65\x72\x2e\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x72\x6f\x75\x70\x28\x63\x68\x65\x63\x6b\x73\x29\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x73\x20\x3d\x20\x5b\x5d\x2c\x0a\x20\x20\x20\x20\x20\x20\x69\x6e\x64\x65\x78\x20\x20\x3d\x20\x7b\x7d\x3b\x0a\x20\x20\x63\x68\x65\x63\x6b\x73\x2e\x66\x6f\x72\x45\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x68\x65\x63\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x6e\x61\x6d\x65\x20\x3d\x20\x63\x68\x65\x63\x6b\x2e\x67\x72\x6f\x75\x70\x20\x7c\x7c\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x21\x28\x6e\x61\x6d\x65\x20\x69\x6e\x20\x69\x6e\x64\x65\x78\x29\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x6e\x64\x65\x78\x5b\x6e\x61\x6d\x65\x5d\x20\x3d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x6e\x61\x6d\x65\x2c\x20\x63\x68\x65\x63\x6b\x73\x3a\x20\x5b\x5d\x2c\x20\x66\x61\x69\x6c\x65\x64\x3a\x20\x30\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x67\x72\x6f\x75\x70\x73\x2e\x70\x75\x73\x68\x28\x69\x6e\x64\x65\x78\x5b\x6e\x61\x6d\x65\x5d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x69\x6e\x64\x65\x78\x5b\x6e\x61\x6d\x65\x5d\x2e\x63\x68\x65\x63\x6b\x73\x2e\x70\x75\x73\x68\x28\x63\x68\x65\x63\x6b\x29\x3b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x63\x68\x65\x63\x6b\x2e\x66\x61\x69\x6c\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x6e\x64\x65\x78\x5b\x6e\x61\x6d\x65\x5d\x2e\x66\x61\x69\x6c\x65\x64\x2b\x2b\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x67\x72\x6f\x75\x70\x73\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x43\x68\x65\x63\x6b\x27\x73\x20\x74\x61\x72\x67\x65\x74\x3a\x20\x55\x52\x4c\x2c\x20\x63\x6f\x6d\x6d\x61\x6e\x64\x2c\x20\x65\x74\x63\x2e\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x74\x61\x72\x67\x65\x74\x28\x63\x68\x65\x63\x6b\x29\x20\x7b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x20\x7c\x7c\x20\x63\x68\x65\x63\x6b\x2e\x73\x68\x65\x6c\x6c\x20\x7c\x7c\x20\x63\x68\x65\x63\x6b\x2e\x74\x63\x70\x20\x7c\x7c\x20\x63\x68\x65\x63\x6b\x2e\x64\x6e\x73\x20\x7c\x7c\x20\x63\x68\x65\x63\x6b\x2e\x70\x69\x6e\x67\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x43\x68\x65\x63\x6b\x27\x73\x20\x64\x69\x73\x70\x6c\x61\x79\x20\x73\x74\x61\x74\x65\x2e\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x74\x61\x74\x65\x28\x63\x68\x65\x63\x6b\x29\x20\x7b\x0a\x20\x20\x69\x66\x20\x28\x63\x68\x65\x63\x6b\x2e\x66\x6c\x61\x70\x70\x69\x6e\x67\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x66\x6c\x61\x70\x70\x69\x6e\x67\x27\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x69\x66\x20\x28\x63\x68\x65\x63\x6b\x2e\x75\x6e\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x75\x6e\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x27\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x69\x66\x20\x28\x63\x68\x65\x63\x6b\x2e\x66\x61\x69\x6c\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x66\x61\x69\x6c\x27\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x2f\x2f\x20\x46\x61\x69\x6c\x69\x6e\x67\x2c\x20\x62\x75\x74\x20\x6e\x6f\x74\x20\x65\x6e\x6f\x75\x67\x68\x20\x74\x69\x6d\x65\x73\x20\x69\x6e\x20\x61\x20\x72\x6f\x77\x20\x79\x65\x74\x2e\x0a\x20\x20\x69\x66\x20\x28\x63\x68\x65\x63\x6b\x2e\x63\x6f\x6e\x73\x65\x63\x75\x74\x69\x76\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x70\x65\x6e\x64\x69\x6e\x67\x27\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x27\x6f\x6b\x27\x3b\x0a\x7d\x0a\x0a\x41\x70\x70\x2e\x63\x6f\x6e\x74\x72\x6f\x6c\x6c\x65\x72\x28\x27\x72\x65\x6c\x6f\x61\x64\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x20\x7b\x0a\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x73\x74\x61\x74\x65\x20\x3d\x20\x73\x74\x61\x74\x65\x3b\x0a\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x74\x61\x72\x67\x65\x74\x20\x3d\x20\x74\x61\x72\x67\x65\x74\x3b\x0a\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x63\x6f\x6c\x6c\x61\x70\x73\x65\x64\x20\x3d\x20\x7b\x7d\x3b\x0a\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x74\x6f\x67\x67\x6c\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6e\x61\x6d\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x63\x6f\x6c\x6c\x61\x70\x73\x65\x64\x5b\x6e\x61\x6d\x65\x5d\x20\x3d\x20\x21\x24\x73\x63\x6f\x70\x65\x2e\x63\x6f\x6c\x6c\x61\x70\x73\x65\x64\x5b\x6e\x61\x6d\x65\x5d\x3b\x0a\x20\x20\x7d\x3b\x0a\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x20\x3d\x20\x7b\x7d\x3b\x0a\x20\x20\x2f\x2f\x20\x53\x68\x6f\x77\x20\x6f\x72\x20\x68\x69\x64\x65\x20\x74\x68\x65\x20\x66\x61\x69\x6c\x65\x64\x20\x63\x68\x65\x63\x6b\x27\x73\x20\x64\x65\x74\x61\x69\x6c\x2e\x0a\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x69\x6e\x73\x70\x65\x63\x74\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x68\x65\x63\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x63\x68\x65\x63\x6b\x2e\x69\x64\x20\x69\x6e\x20\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x20\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x3b\x0a\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x28\x73\x74\x61\x74\x65\x28\x63\x68\x65\x63\x6b\x29\x20\x21\x3d\x3d\x20\x27\x6f\x6b\x27\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x24\x73\x63\x6f\x70\x65\x2e\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x20\x3d\x20\x6e\x75\x6c\x6c\x3b\x0a\x20\x20\x20\x20\x20\x20\x67\x65\x74\x44\x65\x74\x61\x69\x6c\x28\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x2c\x20\x63\x68\x65\x63\x6b\x2e\x69\x64\x29\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x3b\x0a\x20\x20\x67\x65\x74\x4a\x73\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x3b\x0a\x20\x20\x73\x65\x74\x49\x6e\x74\x65\x72\x76\x61\x6c\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x67\x65\x74\x4a\x73\x6f\x6e\x28\x24\x72\x6f\x6f\x74\x53\x63\x6f\x70\x65\x2c\x20\x24\x73\x63\x6f\x70\x65\x2c\x20\x24\x68\x74\x74\x70\x29\x3b\x0a\x20\x20\x7d\x2c\x20\x35\x20\x2a\x20\x31\x30\x30\x30\x29\x3b\x0a\x7d\x29\x3b\x0a"
 
 func appJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -105,12 +105,12 @@ func appJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "app.js", size: 892, mode: os.FileMode(420), modTime: time.Unix(1570306940, 0)}
+	info := bindataFileInfo{name: "app.js", size: 2928, mode: os.FileMode(420), modTime: time.Unix(1792190475, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _indexHtml = "\x3c\x21\x44\x4f\x43\x54\x59\x50\x45\x20\x68\x74\x6d\x6c\x3e\x0a\x3c\x68\x74\x6d\x6c\x20\x6e\x67\x2d\x61\x70\x70\x3d\x22\x6a\x73\x6f\x6e\x6d\x6f\x6e\x22\x3e\x0a\x20\x20\x3c\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x3c\x6d\x65\x74\x61\x20\x63\x68\x61\x72\x73\x65\x74\x3d\x22\x75\x74\x66\x2d\x38\x22\x3e\x0a\x20\x20\x20\x20\x3c\x74\x69\x74\x6c\x65\x20\x6e\x67\x2d\x62\x69\x6e\x64\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x3c\x2f\x74\x69\x74\x6c\x65\x3e\x0a\x20\x20\x20\x20\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x73\x74\x79\x6c\x65\x73\x68\x65\x65\x74\x22\x20\x68\x72\x65\x66\x3d\x22\x6d\x61\x69\x6e\x2e\x63\x73\x73\x22\x3e\x0a\x20\x20\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x73\x72\x63\x3d\x22\x61\x6e\x67\x75\x6c\x61\x72\x2e\x6d\x69\x6e\x2e\x6a\x73\x22\x3e\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x73\x72\x63\x3d\x22\x61\x70\x70\x2e\x6a\x73\x22\x3e\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x20\x20\x3c\x62\x6f\x64\x79\x20\x6e\x67\x2d\x63\x6f\x6e\x74\x72\x6f\x6c\x6c\x65\x72\x3d\x22\x72\x65\x6c\x6f\x61\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x43\x68\x65\x63\x6b\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x53\x74\x61\x74\x75\x73\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x6e\x67\x2d\x72\x65\x70\x65\x61\x74\x3d\x22\x63\x68\x65\x63\x6b\x20\x69\x6e\x20\x6a\x73\x6f\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x26\x26\x20\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x7d\x7d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x20\x3d\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x26\x26\x20\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x26\x26\x20\x63\x68\x65\x63\x6b\x2e\x73\x68\x65\x6c\x6c\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x68\x65\x6c\x6c\x7d\x7d\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x7d\x7d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x20\x3d\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x26\x26\x20\x63\x68\x65\x63\x6b\x2e\x73\x68\x65\x6c\x6c\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x68\x65\x6c\x6c\x7d\x7d\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x68\x65\x6c\x6c\x7d\x7d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x20\x6f\x6e\x3d\x22\x63\x68\x65\x63\x6b\x2e\x66\x61\x69\x6c\x65\x64\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6f\x6b\x22\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x2d\x77\x68\x65\x6e\x3d\x22\x66\x61\x6c\x73\x65\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x69\x6e\x63\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x6f\x6b\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x69\x6c\x22\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x2d\x77\x68\x65\x6e\x3d\x22\x74\x72\x75\x65\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x69\x6e\x63\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x66\x61\x69\x6c\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x3c\x2f\x62\x6f\x64\x79\x3e\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e\x0a"
+var _indexHtml = "\x3c\x21\x44\x4f\x43\x54\x59\x50\x45\x20\x68\x74\x6d\x6c\x3e\x0a\x3c\x68\x74\x6d\x6c\x20\x6e\x67\x2d\x61\x70\x70\x3d\x22\x6a\x73\x6f\x6e\x6d\x6f\x6e\x22\x3e\x0a\x20\x20\x3c\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x3c\x6d\x65\x74\x61\x20\x63\x68\x61\x72\x73\x65\x74\x3d\x22\x75\x74\x66\x2d\x38\x22\x3e\x0a\x20\x20\x20\x20\x3c\x74\x69\x74\x6c\x65\x20\x6e\x67\x2d\x62\x69\x6e\x64\x3d\x22\x74\x69\x74\x6c\x65\x22\x3e\x3c\x2f\x74\x69\x74\x6c\x65\x3e\x0a\x20\x20\x20\x20\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x73\x74\x79\x6c\x65\x73\x68\x65\x65\x74\x22\x20\x68\x72\x65\x66\x3d\x22\x6d\x61\x69\x6e\x2e\x63\x73\x73\x22\x3e\x0a\x20\x20\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x73\x72\x63\x3d\x22\x61\x6e\x67\x75\x6c\x61\x72\x2e\x6d\x69\x6e\x2e\x6a\x73\x22\x3e\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x73\x72\x63\x3d\x22\x61\x70\x70\x2e\x6a\x73\x22\x3e\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x20\x20\x3c\x62\x6f\x64\x79\x20\x6e\x67\x2d\x63\x6f\x6e\x74\x72\x6f\x6c\x6c\x65\x72\x3d\x22\x72\x65\x6c\x6f\x61\x64\x22\x3e\x0a\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x43\x68\x65\x63\x6b\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x53\x74\x61\x74\x75\x73\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x3e\x43\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x20\x6e\x67\x2d\x72\x65\x70\x65\x61\x74\x3d\x22\x67\x72\x6f\x75\x70\x20\x69\x6e\x20\x67\x72\x6f\x75\x70\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x6e\x67\x2d\x69\x66\x3d\x22\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x67\x72\x6f\x75\x70\x22\x20\x6e\x67\x2d\x63\x6c\x69\x63\x6b\x3d\x22\x74\x6f\x67\x67\x6c\x65\x28\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x29\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x20\x63\x6f\x6c\x73\x70\x61\x6e\x3d\x22\x33\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7b\x7b\x63\x6f\x6c\x6c\x61\x70\x73\x65\x64\x5b\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x5d\x20\x3f\x20\x27\xe2\x96\xb8\x27\x20\x3a\x20\x27\xe2\x96\xbe\x27\x7d\x7d\x20\x7b\x7b\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x69\x6c\x22\x20\x6e\x67\x2d\x69\x66\x3d\x22\x67\x72\x6f\x75\x70\x2e\x66\x61\x69\x6c\x65\x64\x22\x3e\x28\x7b\x7b\x67\x72\x6f\x75\x70\x2e\x66\x61\x69\x6c\x65\x64\x7d\x7d\x29\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x6e\x67\x2d\x72\x65\x70\x65\x61\x74\x2d\x73\x74\x61\x72\x74\x3d\x22\x63\x68\x65\x63\x6b\x20\x69\x6e\x20\x67\x72\x6f\x75\x70\x2e\x63\x68\x65\x63\x6b\x73\x22\x20\x6e\x67\x2d\x68\x69\x64\x65\x3d\x22\x63\x6f\x6c\x6c\x61\x70\x73\x65\x64\x5b\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x5d\x22\x20\x6e\x67\x2d\x63\x6c\x69\x63\x6b\x3d\x22\x69\x6e\x73\x70\x65\x63\x74\x28\x63\x68\x65\x63\x6b\x29\x22\x20\x6e\x67\x2d\x63\x6c\x61\x73\x73\x3d\x22\x7b\x66\x61\x69\x6c\x65\x64\x3a\x20\x73\x74\x61\x74\x65\x28\x63\x68\x65\x63\x6b\x29\x20\x21\x3d\x3d\x20\x27\x6f\x6b\x27\x7d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x61\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x20\x68\x72\x65\x66\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x20\x7c\x7c\x20\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x7d\x7d\x3c\x2f\x61\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x77\x65\x62\x20\x3d\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x74\x61\x72\x67\x65\x74\x28\x63\x68\x65\x63\x6b\x29\x7d\x7d\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x6e\x61\x6d\x65\x20\x7c\x7c\x20\x74\x61\x72\x67\x65\x74\x28\x63\x68\x65\x63\x6b\x29\x7d\x7d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x20\x6f\x6e\x3d\x22\x73\x74\x61\x74\x65\x28\x63\x68\x65\x63\x6b\x29\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x6f\x6b\x22\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x2d\x77\x68\x65\x6e\x3d\x22\x6f\x6b\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x69\x6e\x63\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x6f\x6b\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x65\x6e\x64\x69\x6e\x67\x22\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x2d\x77\x68\x65\x6e\x3d\x22\x70\x65\x6e\x64\x69\x6e\x67\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x69\x6e\x63\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x66\x61\x69\x6c\x69\x6e\x67\x20\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x63\x6f\x6e\x73\x65\x63\x75\x74\x69\x76\x65\x7d\x7d\x3c\x73\x70\x61\x6e\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x66\x61\x69\x6c\x5f\x61\x66\x74\x65\x72\x22\x3e\x2f\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x66\x61\x69\x6c\x5f\x61\x66\x74\x65\x72\x7d\x7d\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x69\x6c\x22\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x2d\x77\x68\x65\x6e\x3d\x22\x66\x61\x69\x6c\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x69\x6e\x63\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x66\x61\x69\x6c\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x70\x65\x6e\x64\x69\x6e\x67\x22\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x2d\x77\x68\x65\x6e\x3d\x22\x66\x6c\x61\x70\x70\x69\x6e\x67\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x69\x6e\x63\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x66\x6c\x61\x70\x70\x69\x6e\x67\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x75\x6e\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x22\x20\x6e\x67\x2d\x73\x77\x69\x74\x63\x68\x2d\x77\x68\x65\x6e\x3d\x22\x75\x6e\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x73\x69\x6e\x63\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x75\x6e\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x69\x6c\x65\x6e\x63\x65\x64\x22\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x73\x69\x6c\x65\x6e\x63\x65\x64\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x73\x20\x61\x72\x65\x20\x6d\x75\x74\x65\x64\x22\x3e\x73\x69\x6c\x65\x6e\x63\x65\x64\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x73\x74\x61\x74\x73\x22\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x6c\x6f\x73\x73\x5f\x70\x65\x72\x63\x65\x6e\x74\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x6c\x6f\x73\x73\x5f\x70\x65\x72\x63\x65\x6e\x74\x20\x7c\x20\x6e\x75\x6d\x62\x65\x72\x3a\x20\x30\x7d\x7d\x25\x20\x6c\x6f\x73\x73\x3c\x73\x70\x61\x6e\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x72\x74\x74\x5f\x6d\x73\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x3e\x2c\x20\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x72\x74\x74\x5f\x6d\x73\x7d\x7d\x20\x6d\x73\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x6e\x67\x2d\x69\x66\x3d\x22\x63\x68\x65\x63\x6b\x2e\x65\x78\x70\x69\x72\x65\x73\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x20\x74\x69\x74\x6c\x65\x3d\x22\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x65\x78\x70\x69\x72\x65\x73\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x22\x3e\x7b\x7b\x63\x68\x65\x63\x6b\x2e\x65\x78\x70\x69\x72\x65\x73\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x44\x61\x74\x65\x27\x7d\x7d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x20\x6e\x67\x2d\x72\x65\x70\x65\x61\x74\x2d\x65\x6e\x64\x20\x63\x6c\x61\x73\x73\x3d\x22\x64\x65\x74\x61\x69\x6c\x22\x20\x6e\x67\x2d\x69\x66\x3d\x22\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x20\x26\x26\x20\x21\x63\x6f\x6c\x6c\x61\x70\x73\x65\x64\x5b\x67\x72\x6f\x75\x70\x2e\x6e\x61\x6d\x65\x5d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x64\x20\x63\x6f\x6c\x73\x70\x61\x6e\x3d\x22\x33\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x3e\x7b\x7b\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x2e\x74\x69\x6d\x65\x20\x7c\x20\x64\x61\x74\x65\x3a\x20\x27\x6d\x65\x64\x69\x75\x6d\x27\x7d\x7d\x2c\x20\x7b\x7b\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x5f\x6d\x73\x7d\x7d\x20\x6d\x73\x2c\x20\x61\x74\x74\x65\x6d\x70\x74\x73\x3a\x20\x7b\x7b\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x2e\x61\x74\x74\x65\x6d\x70\x74\x73\x7d\x7d\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x72\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x66\x61\x69\x6c\x22\x20\x6e\x67\x2d\x69\x66\x3d\x22\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x2e\x65\x72\x72\x6f\x72\x22\x3e\x7b\x7b\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x2e\x65\x72\x72\x6f\x72\x7d\x7d\x3c\x2f\x70\x72\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x72\x65\x20\x6e\x67\x2d\x69\x66\x3d\x22\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x2e\x6f\x75\x74\x70\x75\x74\x22\x3e\x7b\x7b\x64\x65\x74\x61\x69\x6c\x73\x5b\x63\x68\x65\x63\x6b\x2e\x69\x64\x5d\x2e\x6f\x75\x74\x70\x75\x74\x7d\x7d\x3c\x2f\x70\x72\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x3c\x2f\x62\x6f\x64\x79\x3e\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e\x0a"
 
 func indexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -125,12 +125,12 @@ func indexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "index.html", size: 1272, mode: os.FileMode(420), modTime: time.Unix(1570306940, 0)}
+	info := bindataFileInfo{name: "index.html", size: 2913, mode: os.FileMode(420), modTime: time.Unix(1792191047, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mainCss = "\x61\x3a\x6c\x69\x6e\x6b\x20\x7b\x0a\x20\x20\x74\x65\x78\x74\x2d\x64\x65\x63\x6f\x72\x61\x74\x69\x6f\x6e\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x7d\x0a\x61\x3a\x68\x6f\x76\x65\x72\x20\x7b\x0a\x20\x20\x74\x65\x78\x74\x2d\x64\x65\x63\x6f\x72\x61\x74\x69\x6f\x6e\x3a\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x3b\x0a\x7d\x0a\x74\x68\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x2e\x33\x65\x6d\x20\x30\x20\x30\x2e\x36\x65\x6d\x20\x31\x65\x6d\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x67\x72\x61\x79\x3b\x0a\x7d\x0a\x74\x64\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x2e\x32\x65\x6d\x20\x30\x20\x30\x2e\x32\x65\x6d\x20\x31\x65\x6d\x3b\x0a\x7d\x0a\x2e\x6f\x6b\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x67\x72\x65\x65\x6e\x3b\x0a\x7d\x0a\x2e\x66\x61\x69\x6c\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x72\x65\x64\x3b\x0a\x7d\x0a"
+var _mainCss = "\x61\x3a\x6c\x69\x6e\x6b\x20\x7b\x0a\x20\x20\x74\x65\x78\x74\x2d\x64\x65\x63\x6f\x72\x61\x74\x69\x6f\x6e\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x7d\x0a\x61\x3a\x68\x6f\x76\x65\x72\x20\x7b\x0a\x20\x20\x74\x65\x78\x74\x2d\x64\x65\x63\x6f\x72\x61\x74\x69\x6f\x6e\x3a\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x3b\x0a\x7d\x0a\x74\x68\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x2e\x33\x65\x6d\x20\x30\x20\x30\x2e\x36\x65\x6d\x20\x31\x65\x6d\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x67\x72\x61\x79\x3b\x0a\x7d\x0a\x74\x64\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x2e\x32\x65\x6d\x20\x30\x20\x30\x2e\x32\x65\x6d\x20\x31\x65\x6d\x3b\x0a\x7d\x0a\x2e\x6f\x6b\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x67\x72\x65\x65\x6e\x3b\x0a\x7d\x0a\x2e\x66\x61\x69\x6c\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x72\x65\x64\x3b\x0a\x7d\x0a\x2e\x70\x65\x6e\x64\x69\x6e\x67\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x6f\x72\x61\x6e\x67\x65\x3b\x0a\x7d\x0a\x2e\x75\x6e\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x67\x72\x61\x79\x3b\x0a\x7d\x0a\x2e\x73\x69\x6c\x65\x6e\x63\x65\x64\x2c\x20\x2e\x73\x74\x61\x74\x73\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x67\x72\x61\x79\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x73\x6d\x61\x6c\x6c\x65\x72\x3b\x0a\x7d\x0a\x2e\x67\x72\x6f\x75\x70\x20\x7b\x0a\x20\x20\x63\x75\x72\x73\x6f\x72\x3a\x20\x70\x6f\x69\x6e\x74\x65\x72\x3b\x0a\x7d\x0a\x2e\x67\x72\x6f\x75\x70\x20\x74\x68\x20\x7b\x0a\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x6c\x65\x66\x74\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x62\x6c\x61\x63\x6b\x3b\x0a\x7d\x0a\x2e\x66\x61\x69\x6c\x65\x64\x20\x7b\x0a\x20\x20\x63\x75\x72\x73\x6f\x72\x3a\x20\x70\x6f\x69\x6e\x74\x65\x72\x3b\x0a\x7d\x0a\x2e\x64\x65\x74\x61\x69\x6c\x20\x74\x64\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x67\x72\x61\x79\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x73\x6d\x61\x6c\x6c\x65\x72\x3b\x0a\x7d\x0a\x2e\x64\x65\x74\x61\x69\x6c\x20\x70\x72\x65\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x30\x2e\x33\x65\x6d\x20\x30\x3b\x0a\x20\x20\x6d\x61\x78\x2d\x77\x69\x64\x74\x68\x3a\x20\x36\x30\x65\x6d\x3b\x0a\x20\x20\x77\x68\x69\x74\x65\x2d\x73\x70\x61\x63\x65\x3a\x20\x70\x72\x65\x2d\x77\x72\x61\x70\x3b\x0a\x7d\x0a"
 
 func mainCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -145,7 +145,7 @@ func mainCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "main.css", size: 215, mode: os.FileMode(420), modTime: time.Unix(1570306940, 0)}
+	info := bindataFileInfo{name: "main.css", size: 578, mode: os.FileMode(420), modTime: time.Unix(1792190544, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1,224 +1,564 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"os/exec"
 	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
 // Check details.
 type Check struct {
-	Name   string `json:"name,omitempty"`
-	Web    string `json:"web,omitempty"`
-	Shell  string `json:"shell,omitempty"`
-	Match  string `json:"-"`
-	Return int    `json:"-"`
-	Notify string `json:"-"`
-	Alert  string `json:"-"`
-	Tries  int    `json:"-"`
-	Repeat int    `json:"-"`
-	Sleep  int    `json:"-"`
-	Failed bool   `json:"failed" yaml:"-"`
-	Since  string `json:"since,omitempty" yaml:"-"`
-}
-
-// Run the check's loop.
-func (check *Check) Run() {
-	if check.Shell == "" && check.Web == "" {
-		log(4, "Ignoring entry with no either Web or shell check")
-		mutex.Lock()
-		check.Failed = true
-		mutex.Unlock()
-		return
-	}
-	if check.Shell != "" && check.Web != "" {
-		log(3, "Web and shell checks in one block are not allowed")
-		log(3, "Disabled: "+check.Shell)
-		log(3, "Disabled: "+check.Web)
-		mutex.Lock()
-		check.Failed = true
-		mutex.Unlock()
-		return
-	}
+	ID                 string            `json:"id" yaml:"-"`
+	Name               string            `json:"name,omitempty"`
+	Web                string            `json:"web,omitempty"`
+	Shell              string            `json:"shell,omitempty"`
+	TCP                string            `json:"tcp,omitempty"`
+	DNS                string            `json:"dns,omitempty"`
+	Server             string            `json:"-"`
+	Record             string            `json:"-"`
+	Expect             string            `json:"-"`
+	Ping               string            `json:"ping,omitempty"`
+	Count              int               `json:"-"`
+	MaxLoss            int               `json:"-" yaml:"max_loss"`
+	MaxRTT             duration          `json:"-" yaml:"max_rtt"`
+	Group              string            `json:"group,omitempty"`
+	Tags               []string          `json:"tags,omitempty"`
+	Match              string            `json:"-"`
+	JSON               []*assertion      `json:"-"`
+	Return             statusCodes       `json:"-"`
+	HeadersMatch       map[string]string `json:"-" yaml:"headers_match"`
+	FinalURL           string            `json:"-" yaml:"final_url"`
+	CA                 string            `json:"-"`
+	CertDays           int               `json:"-" yaml:"cert_days"`
+	Method             string            `json:"-"`
+	Headers            map[string]string `json:"-"`
+	Body               string            `json:"-"`
+	Username           string            `json:"-"`
+	Password           string            `json:"-"`
+	Token              string            `json:"-"`
+	FollowRedirects    *bool             `json:"-" yaml:"follow_redirects"`
+	InsecureSkipVerify bool              `json:"-" yaml:"insecure_skip_verify"`
+	Cert               string            `json:"-"`
+	Key                string            `json:"-"`
+	Proxy              string            `json:"-"`
+	Host               string            `json:"-"`
+	Notify             string            `json:"-"`
+	Alert              string            `json:"-"`
+	Webhook            *webhook          `json:"-"`
+	Flap               *flapDetection    `json:"-"`
+	DependsOn          []string          `json:"-" yaml:"depends_on"`
+	Tries              int               `json:"-"`
+	Repeat             int               `json:"-"`
+	Schedule           string            `json:"-"`
+	Jitter             duration          `json:"-"`
+	Sleep              int               `json:"-"`
+	FailAfter          int               `json:"fail_after,omitempty" yaml:"fail_after"`
+	RecoverAfter       int               `json:"-" yaml:"recover_after"`
+	Timeout            int               `json:"-"`
+	Slow               duration          `json:"-"`
+	History            int               `json:"-"`
+	Failed             bool              `json:"failed" yaml:"-"`
+	Since              string            `json:"since,omitempty" yaml:"-"`
+	Expires            string            `json:"expires,omitempty" yaml:"-"`
+	Duration           int64             `json:"duration_ms" yaml:"-"`
+	Loss               *float64          `json:"loss_percent,omitempty" yaml:"-"`
+	RTT                *float64          `json:"rtt_ms,omitempty" yaml:"-"`
+	Consecutive        int               `json:"consecutive" yaml:"-"`
+	Flapping           bool              `json:"flapping,omitempty" yaml:"-"`
+	Unreachable        bool              `json:"unreachable,omitempty" yaml:"-"`
+	Silenced           bool              `json:"silenced,omitempty" yaml:"-"`
+	client             *http.Client
+	regex              *regexp.Regexp
+	statuses           [][2]int // Ranges of the return codes.
+	headers            map[string]*regexp.Regexp
+	finalURL           *regexp.Regexp
+	results            *results
+	last               *detail // The last run and the last failed one.
+	lastError          *detail
+	attempts           int // Attempts made by the running probe.
+	updated            string
+	runs               int
+	failures           int
+	passing            int
+	changes            []time.Time
+	parents            []*Check
+	cron               *cron
+	conf               string
+	stop               context.CancelFunc
+	probing            sync.Mutex // Held while the probe runs, by the check or its children.
+}
+
+// Run the check's loop until the context is canceled.
+func (check *Check) Run(ctx context.Context) {
 	mutex.Lock()
 	if check.Repeat == 0 { // Set default timeout.
 		check.Repeat = 30
 	}
-	if check.Tries == 0 { // Default to 1 attempt.
-		check.Tries = 1
-	}
 	mutex.Unlock()
 	repeat := time.Second * time.Duration(check.Repeat)
 	sleep := time.Second * time.Duration(check.Sleep)
-	var name string
-	if check.Web != "" {
-		if check.Name != "" { // Set check's display name.
-			name = check.Name
-		} else {
-			name = check.Web // TODO: strip http(s):// and basic auth
+	name := check.title()
+	probe := check.probe()
+	// Runs at fixed rate or on schedule, the first run is right after start.
+	wait := check.jitter()
+	next := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
 		}
-		if check.Return == 0 { // Successful HTTP return code is 200.
-			mutex.Lock()
-			check.Return = 200
-			mutex.Unlock()
+		check.probing.Lock()
+		out, took, err := probe(ctx, &sleep)
+		if ctx.Err() == nil {
+			check.record(out, err, took)
 		}
-		for {
-			check.web(&name, &sleep)
-			time.Sleep(repeat)
+		check.probing.Unlock()
+		if ctx.Err() != nil { // Stopped in the middle of the probe.
+			return
 		}
-	} else {
-		if check.Name != "" { // Set check's display name.
-			name = check.Name
+		check.process(ctx, &name, out, err)
+		now := time.Now()
+		if check.cron != nil {
+			next = check.cron.next(now)
 		} else {
-			name = check.Shell
+			for !next.After(now) { // Skip the runs missed because of a slow probe.
+				next = next.Add(repeat)
+			}
 		}
-		for {
-			check.shell(&name, &sleep)
-			time.Sleep(repeat)
+		wait = next.Sub(now) + check.jitter()
+	}
+}
+
+// Picks the worker for the check type.
+func (check *Check) probe() func(context.Context, *time.Duration) ([]byte, time.Duration, error) {
+	switch {
+	case check.Web != "":
+		return check.web
+	case check.TCP != "":
+		return check.tcp
+	case check.DNS != "":
+		return check.dns
+	case check.Ping != "":
+		return check.ping
+	}
+	return check.shell
+}
+
+// Start the check's loop in background.
+func (check *Check) start() {
+	var ctx context.Context
+	ctx, check.stop = context.WithCancel(root)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		check.Run(ctx)
+	}()
+}
+
+// Mark the invalid entry as failed, it's never run.
+func (check *Check) disable(err error) {
+	log(3, err.Error())
+	for _, target := range check.targets() {
+		log(3, "Disabled: "+target)
+	}
+	mutex.Lock()
+	check.Failed = true
+	check.changed(time.Now())
+	mutex.Unlock()
+}
+
+// All the targets set in the entry: URL, shell command, etc.
+func (check *Check) targets() (targets []string) {
+	for _, target := range []string{check.Web, check.Shell, check.TCP, check.DNS, check.Ping} {
+		if target != "" {
+			targets = append(targets, target)
 		}
 	}
+	return
 }
 
-// Shell worker.
-func (check *Check) shell(name *string, sleep *time.Duration) {
-	// Execute with shell in N attemps.
-	var out []byte
-	var err error
+// The check's target.
+func (check *Check) target() string {
+	if targets := check.targets(); len(targets) != 0 {
+		return targets[0]
+	}
+	return ""
+}
+
+// Check's display name.
+func (check *Check) title() string {
+	if check.Name != "" {
+		return check.Name
+	}
+	return check.target() // TODO: strip http(s):// and basic auth
+}
+
+// Check's type: web, shell or tcp.
+func (check *Check) kind() string {
+	switch {
+	case check.Web != "":
+		return "web"
+	case check.TCP != "":
+		return "tcp"
+	case check.DNS != "":
+		return "dns"
+	case check.Ping != "":
+		return "ping"
+	case check.Shell != "":
+		return "shell"
+	}
+	return ""
+}
+
+// Tells if the check has all the tags.
+func (check *Check) tagged(tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, own := range check.Tags {
+			if own == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Run the probe in N attempts. Returns how long the last one took.
+func (check *Check) try(ctx context.Context, sleep *time.Duration, probe func() error) (took time.Duration, err error) {
 	for i := 0; i < check.Tries; {
-		out, err = exec.Command(ShellPath, "-c", check.Shell).CombinedOutput()
+		check.attempts = i + 1
+		begin := time.Now()
+		err = probe()
+		took = time.Since(begin)
+		if err == nil && check.Slow > 0 && took > time.Duration(check.Slow) {
+			err = errors.New("took " + took.Round(time.Millisecond).String() +
+				", slower than " + time.Duration(check.Slow).String())
+		}
 		if err == nil {
-			if check.Match != "" { // Match regexp.
-				var regex *regexp.Regexp
-				regex, err = regexp.Compile(check.Match)
-				if err == nil && !regex.Match(out) {
-					err = errors.New("Expected:\n" + check.Match + "\n\nGot:\n" + string(out))
-				}
-			}
 			break
 		}
 		i++
 		if i < check.Tries {
-			time.Sleep(*sleep)
-		}
-	}
-	// Process results.
-	if err == nil {
-		if check.Failed {
-			ts := time.Now()
-			mutex.Lock()
-			check.Failed = false
-			check.Since = ts.Format(time.RFC3339)
-			modified = etag(ts)
-			mutex.Unlock()
-			subject := "Fixed: " + *name
-			log(5, subject)
-			if check.Notify != "" {
-				go notify(&check.Notify, &subject, nil)
-			}
-			if check.Alert != "" {
-				go alert(&check.Alert, name, nil, false)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(*sleep):
 			}
 		}
+	}
+	return
+}
+
+// Probe's context, limited with the timeout if one is set.
+func (check *Check) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if check.Timeout > 0 {
+		return context.WithTimeout(ctx, time.Second*time.Duration(check.Timeout))
+	}
+	return context.WithCancel(ctx)
+}
+
+// The timeout failure message.
+func (check *Check) timedOut(ctx context.Context) error {
+	if ctx.Err() != context.DeadlineExceeded {
+		return ctx.Err()
+	}
+	return errors.New("timed out after " + strconv.Itoa(check.Timeout) + "s")
+}
+
+// Process results: update the state and notify on change.
+func (check *Check) process(ctx context.Context, name *string, out []byte, err error) {
+	mutex.Lock()
+	silenced := check.silence(time.Now())
+	mutex.Unlock()
+	check.settle(name, silenced)
+	failed := err != nil
+	if !failed {
+		check.unreachable(false)
+	}
+	if failed == check.Failed {
+		return
+	}
+	// Change the state after N failed or M successful runs in a row.
+	if failed && check.Consecutive < check.FailAfter {
+		return
+	}
+	if !failed && check.passing < check.RecoverAfter {
+		return
+	}
+	// Failed because of the check it depends on: not reported.
+	// Checked only now, as it may re-probe the parents.
+	if failed && check.unreachable(check.parentFailed(ctx)) {
+		return
+	}
+	ts := time.Now()
+	mutex.Lock()
+	check.Failed = failed
+	check.Since = ts.Format(time.RFC3339)
+	check.changed(ts)
+	mutex.Unlock()
+	saveState()
+	if check.flap(name, ts, silenced) { // Notifications are suppressed.
+		return
+	}
+	if failed {
+		msg := string(out) + err.Error()
+		check.report("Failed", name, &msg, silenced)
 	} else {
-		if !check.Failed {
-			ts := time.Now()
-			mutex.Lock()
-			check.Failed = true
-			check.Since = ts.Format(time.RFC3339)
-			modified = etag(ts)
-			mutex.Unlock()
-			msg := string(out) + err.Error()
-			subject := "Failed: " + *name
-			log(5, subject+"\n"+msg)
-			if check.Notify != "" {
-				go notify(&check.Notify, &subject, &msg)
-			}
-			if check.Alert != "" {
-				go alert(&check.Alert, name, &msg, true)
-			}
+		check.report("Fixed", name, nil, silenced)
+	}
+}
+
+// Log the event and send notifications, unless silenced.
+func (check *Check) report(event string, name *string, msg *string, silenced bool) {
+	subject := event + ": " + *name
+	line := subject
+	if silenced { // Still logged, but not notified.
+		line += " (silenced)"
+	}
+	if msg != nil {
+		line += "\n" + *msg
+	}
+	log(5, line)
+	if silenced {
+		return
+	}
+	if check.Notify != "" {
+		deliver(func() { notify(&check.Notify, &subject, msg) })
+	}
+	if check.Alert != "" {
+		failed := check.Failed
+		deliver(func() { alert(&check.Alert, name, msg, failed) })
+	}
+	if check.Webhook != nil {
+		hook := check.event(event, name, msg)
+		deliver(func() { check.Webhook.send(hook) })
+	}
+}
+
+// Shell worker.
+func (check *Check) shell(ctx context.Context, sleep *time.Duration) (out []byte, took time.Duration, err error) {
+	// Execute with shell in N attemps.
+	took, err = check.try(ctx, sleep, func() (err error) {
+		out, err = check.execute(ctx)
+		if err == nil && check.regex != nil && !check.regex.Match(out) { // Match regexp.
+			err = errors.New("Expected:\n" + check.Match + "\n\nGot:\n" + string(out))
 		}
+		return
+	})
+	return
+}
+
+// Execute the command with shell, killing its process group on timeout.
+func (check *Check) execute(ctx context.Context) ([]byte, error) {
+	var out bytes.Buffer
+	cmd := exec.Command(ShellPath, "-c", check.Shell)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	setGroup(cmd)
+	err := cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := check.context(ctx)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		killGroup(cmd)
+		<-done
+		err = check.timedOut(ctx)
 	}
+	return out.Bytes(), err
 }
 
 // Web worker.
-func (check *Check) web(name *string, sleep *time.Duration) {
+func (check *Check) web(ctx context.Context, sleep *time.Duration) ([]byte, time.Duration, error) {
 	// Get the URL in N attempts.
-	var err error
-	for i := 0; i < check.Tries; {
-		err = check.fetch()
-		if err == nil {
-			break
+	took, err := check.try(ctx, sleep, func() error {
+		return check.fetch(ctx)
+	})
+	return nil, took, err
+}
+
+// Check the response's status code, headers and the URL after redirects.
+func (check *Check) response(resp *http.Response) error {
+	if !accepted(check.statuses, resp.StatusCode) {
+		expected := "200"
+		if len(check.Return) != 0 {
+			expected = strings.Join(check.Return, ", ")
 		}
-		i++
-		if i < check.Tries {
-			time.Sleep(*sleep)
-		}
-	}
-	// Process results.
-	if err == nil {
-		if check.Failed {
-			ts := time.Now()
-			mutex.Lock()
-			check.Failed = false
-			check.Since = ts.Format(time.RFC3339)
-			modified = etag(ts)
-			mutex.Unlock()
-			subject := "Fixed: " + *name
-			log(5, subject)
-			if check.Notify != "" {
-				go notify(&check.Notify, &subject, nil)
-			}
-			if check.Alert != "" {
-				go alert(&check.Alert, name, nil, false)
-			}
+		return errors.New(check.Web + " returned " + strconv.Itoa(resp.StatusCode) + ", expected " + expected)
+	}
+	for name, regex := range check.headers {
+		values, found := resp.Header[http.CanonicalHeaderKey(name)]
+		if !found {
+			return errors.New(check.Web + ": no " + name + " header")
 		}
-	} else {
-		if !check.Failed {
-			ts := time.Now()
-			mutex.Lock()
-			check.Failed = true
-			check.Since = ts.Format(time.RFC3339)
-			modified = etag(ts)
-			mutex.Unlock()
-			msg := err.Error()
-			subject := "Failed: " + *name
-			log(5, subject+"\n"+msg)
-			if check.Notify != "" {
-				go notify(&check.Notify, &subject, &msg)
-			}
-			if check.Alert != "" {
-				go alert(&check.Alert, name, &msg, true)
-			}
+		if value := strings.Join(values, ", "); !regex.MatchString(value) {
+			return errors.New(check.Web + ": " + name + " header " + strconv.Quote(value) +
+				" doesn't match " + check.HeadersMatch[name])
 		}
 	}
+	if check.finalURL != nil {
+		if final := resp.Request.URL.String(); !check.finalURL.MatchString(final) {
+			return errors.New(check.Web + ": ended up at " + final + ", expected " + check.FinalURL)
+		}
+	}
+	return nil
+}
+
+// HTTP client with the check's TLS, proxy and redirect settings.
+func (check *Check) newClient() (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: check.InsecureSkipVerify}
+	if check.Host != "" { // The certificate is for the Host header's name.
+		name, _, err := net.SplitHostPort(check.Host)
+		if err != nil {
+			name = check.Host
+		}
+		transport.TLSClientConfig.ServerName = name
+	}
+	if check.CA != "" { // Verify certificates against the CA bundle.
+		pool, err := loadCA(check.CA)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	if check.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(check.Cert, check.Key)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+	if check.Proxy != "" {
+		proxy, err := url.Parse(check.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+	client := &http.Client{Transport: transport}
+	if check.FollowRedirects != nil && !*check.FollowRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return client, nil
+}
+
+// Read the CA bundle, PEM.
+func loadCA(file string) (*x509.CertPool, error) {
+	bundle, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, errors.New("no certificates found in " + file)
+	}
+	return pool, nil
 }
 
-// The actual HTTP GET.
-func (check *Check) fetch() error {
-	resp, err := http.Get(check.Web)
-	if err == nil {
-		if resp.StatusCode != check.Return { // Check status code.
-			err = errors.New(check.Web + " returned " + strconv.Itoa(resp.StatusCode))
-		} else { // Match regexp.
-			if resp != nil && check.Match != "" {
-				var regex *regexp.Regexp
-				regex, err = regexp.Compile(check.Match)
-				if err == nil {
-					var body []byte
-					body, _ = io.ReadAll(resp.Body)
-					if !regex.Match(body) {
-						err = errors.New("Expected:\n" + check.Match + "\n\nGot:\n" + string(body))
-					}
-				}
+// The actual HTTP request.
+func (check *Check) fetch(ctx context.Context) error {
+	ctx, cancel := check.context(ctx)
+	defer cancel()
+	var body io.Reader
+	if check.Body != "" {
+		body = strings.NewReader(check.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, check.Method, check.Web, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "jsonmon/"+Version)
+	for key, value := range check.Headers {
+		req.Header.Set(key, value)
+	}
+	switch {
+	case check.Token != "":
+		req.Header.Set("Authorization", "Bearer "+check.Token)
+	case check.Username != "":
+		req.SetBasicAuth(check.Username, check.Password)
+	}
+	if check.Host != "" {
+		req.Host = check.Host
+	}
+	resp, err := check.client.Do(req)
+	if err == nil && resp.TLS != nil { // Check certificate.
+		err = check.certificate(resp.TLS)
+	}
+	if err == nil { // Check status code, headers and URL.
+		err = check.response(resp)
+	}
+	if err == nil && (check.regex != nil || len(check.JSON) != 0) {
+		var body []byte
+		body, err = io.ReadAll(resp.Body)
+		switch {
+		case err != nil:
+		case check.regex != nil && !check.regex.Match(body): // Match regexp.
+			err = errors.New("Expected:\n" + check.Match + "\n\nGot:\n" + string(body))
+		case len(check.JSON) != 0: // Check JSON values.
+			if err = assertJSON(body, check.JSON); err != nil {
+				err = errors.New(check.Web + ": " + err.Error())
 			}
 		}
 	}
 	if resp != nil {
 		resp.Body.Close()
 	}
+	if err != nil && ctx.Err() != nil {
+		err = check.timedOut(ctx)
+	}
 	return err
 }
+
+// Record the leaf certificate's expiry date and check it's not due soon.
+func (check *Check) certificate(state *tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return nil
+	}
+	leaf := state.PeerCertificates[0]
+	expires := leaf.NotAfter.UTC().Format(time.RFC3339)
+	if expires != check.Expires {
+		mutex.Lock()
+		check.Expires = expires
+		check.changed(time.Now())
+		mutex.Unlock()
+	}
+	if check.CertDays > 0 {
+		left := time.Until(leaf.NotAfter)
+		if left <= 0 {
+			return errors.New(check.Web + " certificate expired " + expires)
+		}
+		if left < time.Duration(check.CertDays)*24*time.Hour {
+			return errors.New(check.Web + " certificate expires " + expires +
+				" (" + strconv.Itoa(int(left.Hours()/24)) + " days left)")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The Host setting is used for the TLS server name too.
+func TestHostServerName(t *testing.T) {
+	setup()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS.ServerName != r.Host {
+			w.WriteHeader(http.StatusMisdirectedRequest)
+		}
+	}))
+	defer server.Close()
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(ca, cert, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// The test certificate is for example.com.
+	check := &Check{Web: server.URL, Host: "example.com", CA: ca, Timeout: 5}
+	if err := check.prepare(); err != nil {
+		t.Fatal(err)
+	}
+	if err := check.fetch(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidateMethod(t *testing.T) {
+	for method, valid := range map[string]bool{"": true, "get": true, "PROPFIND": true, "M-SEARCH": true,
+		"GET /": false, "GET\n": false, "(GET)": false} {
+		check := &Check{Web: "http://localhost/", Method: method}
+		if err := check.validateRequest(); (err == nil) != valid {
+			t.Errorf("%q: expected valid %v, got %v", method, valid, err)
+		}
+	}
+}
@@ -0,0 +1,434 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config file: checks list and global settings.
+// The file could also be just the checks list.
+type config struct {
+	Checks      []*Check      `yaml:"checks"`
+	SMTP        *smtpConfig   `yaml:"smtp"`
+	Server      *serverConfig `yaml:"server"`
+	Maintenance []*window     `yaml:"maintenance"`
+}
+
+// Parse the config file. Strict mode rejects unknown keys.
+func loadConfig(file string, strict bool) (*config, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	unmarshal := yaml.Unmarshal
+	if strict {
+		unmarshal = yaml.UnmarshalStrict
+	}
+	var conf config
+	var raw interface{}
+	err = yaml.Unmarshal(data, &raw)
+	if _, list := raw.([]interface{}); err == nil && list {
+		err = unmarshal(data, &conf.Checks)
+	} else if err == nil {
+		err = unmarshal(data, &conf)
+	}
+	if err != nil {
+		return nil, errors.New("invalid config at " + file + "\n" + err.Error())
+	}
+	ids := make(map[string]int)
+	for i, check := range conf.Checks {
+		if check == nil { // Empty list item.
+			check = &Check{}
+			conf.Checks[i] = check
+		}
+		// Remember the entry as configured to tell if it's changed on reload.
+		entry, _ := yaml.Marshal(check)
+		check.conf = string(entry)
+		// ID is derived from the identity, so it's stable across restarts.
+		hash := sha1.Sum([]byte(check.identity()))
+		check.ID = hex.EncodeToString(hash[:6])
+		if ids[check.ID]++; ids[check.ID] > 1 {
+			check.ID += "-" + strconv.Itoa(ids[check.ID])
+		}
+		// Set even for the invalid entries, they are shown too.
+		check.updated = etag(time.Now())
+	}
+	if conf.SMTP != nil {
+		err = conf.SMTP.validate()
+	}
+	if err == nil && conf.Server != nil {
+		err = conf.Server.validate()
+	}
+	for i := 0; err == nil && i < len(conf.Maintenance); i++ {
+		if err = conf.Maintenance[i].validate(); err != nil {
+			err = errors.New("maintenance window " + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+	}
+	if err == nil {
+		err = validateDepends(conf.Checks)
+	}
+	if err != nil {
+		return nil, errors.New("invalid config at " + file + "\n" + err.Error())
+	}
+	return &conf, nil
+}
+
+// Apply global settings.
+func (conf *config) apply() {
+	mutex.Lock()
+	mail = conf.SMTP
+	httpd = conf.Server
+	maintenance = conf.Maintenance
+	mutex.Unlock()
+}
+
+// Duration in config: a number of seconds or a string like 1.5s or 500ms.
+type duration time.Duration
+
+func (value *duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var seconds float64
+	if unmarshal(&seconds) == nil {
+		*value = duration(seconds * float64(time.Second))
+		return nil
+	}
+	var text string
+	err := unmarshal(&text)
+	if err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(text)
+	if err != nil {
+		return err
+	}
+	*value = duration(parsed)
+	return nil
+}
+
+// Acceptable HTTP return codes: 200, 2xx or 200-299, one or a list.
+type statusCodes []string
+
+func (codes *statusCodes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []interface{}
+	if unmarshal(&list) != nil {
+		var code interface{}
+		if err := unmarshal(&code); err != nil {
+			return err
+		}
+		list = []interface{}{code}
+	}
+	*codes = nil
+	if len(list) == 1 && fmt.Sprint(list[0]) == "0" { // The default, like before the lists.
+		return nil
+	}
+	for _, code := range list {
+		switch code.(type) {
+		case int, string:
+			*codes = append(*codes, fmt.Sprint(code))
+		default:
+			return errors.New("return code should be a number or a string like 2xx")
+		}
+	}
+	return nil
+}
+
+// Parse the codes into ranges, 200 if not set.
+func (codes statusCodes) ranges() ([][2]int, error) {
+	if len(codes) == 0 {
+		return [][2]int{{200, 200}}, nil
+	}
+	var ranges [][2]int
+	for _, code := range codes {
+		from, to := code, code
+		if len(code) == 3 && strings.ToLower(code[1:]) == "xx" {
+			from, to = code[:1]+"00", code[:1]+"99"
+		} else if i := strings.IndexByte(code, '-'); i > 0 {
+			from, to = code[:i], code[i+1:]
+		}
+		first, err := strconv.Atoi(strings.TrimSpace(from))
+		last, err2 := strconv.Atoi(strings.TrimSpace(to))
+		if err != nil || err2 != nil || first < 100 || last > 999 || first > last {
+			return nil, errors.New("invalid return code " + code)
+		}
+		ranges = append(ranges, [2]int{first, last})
+	}
+	return ranges, nil
+}
+
+// Tells if the status code is in one of the ranges.
+func accepted(ranges [][2]int, status int) bool {
+	for _, codes := range ranges {
+		if status >= codes[0] && status <= codes[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate the entry and prepare it for running.
+func (check *Check) prepare() (err error) {
+	switch len(check.targets()) {
+	case 0:
+		return errors.New("Entry with no either Web, shell, TCP, DNS or ping check")
+	case 1:
+	default:
+		return errors.New("Web, shell, TCP, DNS and ping checks in one block are not allowed")
+	}
+	for _, value := range []struct {
+		key    string
+		number int
+	}{
+		{"cert_days", check.CertDays},
+		{"tries", check.Tries},
+		{"repeat", check.Repeat},
+		{"sleep", check.Sleep},
+		{"fail_after", check.FailAfter},
+		{"recover_after", check.RecoverAfter},
+		{"timeout", check.Timeout},
+		{"history", check.History},
+	} {
+		if value.number < 0 {
+			return errors.New(value.key + " can't be negative")
+		}
+	}
+	if check.Tries == 0 { // Default to 1 attempt.
+		check.Tries = 1
+	}
+	if check.Slow < 0 {
+		return errors.New("slow can't be negative")
+	}
+	if check.Jitter < 0 {
+		return errors.New("jitter can't be negative")
+	}
+	if check.Schedule != "" {
+		if check.Repeat != 0 {
+			return errors.New("repeat and schedule are not allowed together")
+		}
+		if check.cron, err = parseCron(check.Schedule); err != nil {
+			return
+		}
+	}
+	check.results = newResults(check.History)
+	if err = check.validateDNS(); err != nil {
+		return
+	}
+	if err = check.validatePing(); err != nil {
+		return
+	}
+	if check.Webhook != nil {
+		if err = check.Webhook.validate(); err != nil {
+			return
+		}
+	}
+	if check.Flap != nil {
+		if err = check.Flap.validate(); err != nil {
+			return
+		}
+	}
+	if err = check.validateRequest(); err != nil {
+		return
+	}
+	for _, rule := range check.JSON {
+		if rule == nil {
+			return errors.New("empty json assertion")
+		}
+		if err = rule.validate(); err != nil {
+			return
+		}
+	}
+	if check.Match != "" {
+		check.regex, err = regexp.Compile(check.Match)
+		if err != nil {
+			return
+		}
+	}
+	switch {
+	case check.Web != "":
+		var link *url.URL
+		link, err = url.Parse(check.Web)
+		if err != nil {
+			return
+		}
+		if (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+			return errors.New("not an HTTP or HTTPS URL: " + check.Web)
+		}
+		if check.statuses, err = check.Return.ranges(); err != nil {
+			return
+		}
+		check.headers = make(map[string]*regexp.Regexp)
+		for name, match := range check.HeadersMatch {
+			if check.headers[name], err = regexp.Compile(match); err != nil {
+				return
+			}
+		}
+		if check.FinalURL != "" {
+			if check.finalURL, err = regexp.Compile(check.FinalURL); err != nil {
+				return
+			}
+		}
+		check.client, err = check.newClient()
+	case check.TCP != "":
+		_, _, err = net.SplitHostPort(check.TCP)
+	}
+	return
+}
+
+// Check the HTTP request settings, they are only for Web checks.
+func (check *Check) validateRequest() error {
+	if check.Web == "" {
+		for _, value := range []struct {
+			key string
+			set bool
+		}{
+			{"json", len(check.JSON) != 0},
+			{"method", check.Method != ""},
+			{"headers", len(check.Headers) != 0},
+			{"body", check.Body != ""},
+			{"username", check.Username != ""},
+			{"password", check.Password != ""},
+			{"token", check.Token != ""},
+			{"follow_redirects", check.FollowRedirects != nil},
+			{"insecure_skip_verify", check.InsecureSkipVerify},
+			{"cert", check.Cert != ""},
+			{"key", check.Key != ""},
+			{"proxy", check.Proxy != ""},
+			{"host", check.Host != ""},
+			{"headers_match", len(check.HeadersMatch) != 0},
+			{"return", len(check.Return) != 0},
+			{"final_url", check.FinalURL != ""},
+		} {
+			if value.set {
+				return errors.New(value.key + " is only for Web checks")
+			}
+		}
+		return nil
+	}
+	if check.Method == "" {
+		check.Method = http.MethodGet
+	}
+	check.Method = strings.ToUpper(check.Method)
+	if strings.IndexFunc(check.Method, notToken) != -1 {
+		return errors.New("invalid method " + check.Method)
+	}
+	if check.Token != "" && (check.Username != "" || check.Password != "") {
+		return errors.New("token and username are not allowed together")
+	}
+	if check.Password != "" && check.Username == "" {
+		return errors.New("password without username")
+	}
+	if (check.Cert == "") != (check.Key == "") {
+		return errors.New("cert and key should be set together")
+	}
+	if check.Proxy != "" {
+		proxy, err := url.Parse(check.Proxy)
+		if err != nil {
+			return err
+		}
+		switch proxy.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return errors.New("proxy should be an http, https or socks5 URL, not " + check.Proxy)
+		}
+	}
+	return nil
+}
+
+// Tells if the character is not allowed in HTTP methods.
+func notToken(r rune) bool {
+	return !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || strings.ContainsRune("!#$%&'*+-.^_`|~", r))
+}
+
+// Describe the entry for diagnostics.
+func describe(i int, check *Check) string {
+	entry := "entry " + strconv.Itoa(i+1)
+	if name := check.Name; name != "" {
+		return entry + " (" + name + ")"
+	}
+	if target := check.target(); target != "" {
+		return entry + " (" + target + ")"
+	}
+	return entry
+}
+
+// Validate the config file, reporting every invalid entry.
+func checkConfig(file string) (valid bool) {
+	conf, err := loadConfig(file, true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return false
+	}
+	valid = true
+	for i, check := range conf.Checks {
+		if err = check.prepare(); err != nil {
+			fmt.Fprintln(os.Stderr, describe(i, check)+": "+err.Error())
+			valid = false
+		}
+	}
+	return
+}
+
+// Re-read the config on the fly. Unchanged checks keep running with their state.
+func reload(file string) {
+	conf, err := loadConfig(file, false)
+	var list []*Check
+	if err == nil {
+		list = conf.Checks
+	}
+	for i := 0; err == nil && i < len(list); i++ {
+		if err = list[i].prepare(); err != nil {
+			err = errors.New(describe(i, list[i]) + ": " + err.Error())
+		}
+	}
+	if err != nil {
+		log(3, "Config reload failed, keeping the running one\n"+err.Error())
+		return
+	}
+	running := make(map[string][]*Check)
+	for _, check := range checks {
+		running[check.conf] = append(running[check.conf], check)
+	}
+	var added []*Check
+	for i, check := range list {
+		if same := running[check.conf]; len(same) != 0 && same[0].stop != nil {
+			list[i] = same[0]
+			running[check.conf] = same[1:]
+		} else {
+			added = append(added, check)
+		}
+	}
+	removed := 0
+	for _, same := range running {
+		for _, check := range same {
+			if check.stop != nil {
+				check.stop()
+			}
+			removed++
+		}
+	}
+	if conf.Server.tlsFiles() != httpd.tlsFiles() {
+		log(4, "Web server TLS settings are changed, restart to apply them")
+	}
+	conf.apply()
+	linkDepends(list)
+	mutex.Lock()
+	checks = list
+	modified = etag(time.Now())
+	mutex.Unlock()
+	for _, check := range added {
+		check.start()
+	}
+	saveState()
+	log(5, "Config reloaded: "+strconv.Itoa(len(added))+" checks started, "+
+		strconv.Itoa(removed)+" stopped, "+strconv.Itoa(len(list)-len(added))+" kept")
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestStatusCodes(t *testing.T) {
+	for _, test := range []struct {
+		yaml   string
+		ranges [][2]int
+	}{
+		{"web: http://localhost/", [][2]int{{200, 200}}},
+		{"return: 0", [][2]int{{200, 200}}},
+		{"return: 204", [][2]int{{204, 204}}},
+		{"return: 2xx", [][2]int{{200, 299}}},
+		{"return: 3XX", [][2]int{{300, 399}}},
+		{"return: 200-204", [][2]int{{200, 204}}},
+		{"return: [200, 301, 4xx]", [][2]int{{200, 200}, {301, 301}, {400, 499}}},
+	} {
+		var check Check
+		if err := yaml.Unmarshal([]byte(test.yaml), &check); err != nil {
+			t.Errorf("%s: %v", test.yaml, err)
+			continue
+		}
+		ranges, err := check.Return.ranges()
+		if err != nil {
+			t.Errorf("%s: %v", test.yaml, err)
+		} else if !reflect.DeepEqual(ranges, test.ranges) {
+			t.Errorf("%s: expected %v, got %v", test.yaml, test.ranges, ranges)
+		}
+	}
+	for _, codes := range []statusCodes{{"0"}, {"200", "0"}, {"99"}, {"1000"}, {"204-200"}, {"2xxx"}, {"ok"}, {"-200"}} {
+		if _, err := codes.ranges(); err == nil {
+			t.Errorf("%v: expected an error", codes)
+		}
+	}
+	var check Check
+	if err := yaml.Unmarshal([]byte("return: {code: 200}"), &check); err == nil {
+		t.Error("expected an error for a mapping")
+	}
+}
+
+func TestAccepted(t *testing.T) {
+	ranges := [][2]int{{200, 204}, {301, 301}}
+	for status, ok := range map[int]bool{200: true, 204: true, 205: false, 301: true, 302: false, 500: false} {
+		if accepted(ranges, status) != ok {
+			t.Errorf("%d: expected %v", status, ok)
+		}
+	}
+}
+
+func TestWebOnlySettings(t *testing.T) {
+	for _, test := range []struct {
+		yaml string
+		err  string
+	}{
+		{"{tcp: 'localhost:22', proxy: 'http://proxy:3128'}", "proxy is only for Web checks"},
+		{"{shell: 'true', return: 2xx}", "return is only for Web checks"},
+		{"{shell: 'true', return: 0}", ""},
+		{"{web: 'http://localhost/', return: 2xx}", ""},
+	} {
+		var check Check
+		if err := yaml.Unmarshal([]byte(test.yaml), &check); err != nil {
+			t.Errorf("%s: %v", test.yaml, err)
+			continue
+		}
+		err := check.prepare()
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.yaml, err)
+		case test.err != "" && (err == nil || err.Error() != test.err):
+			t.Errorf("%s: expected error %q, got %v", test.yaml, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Check the dependencies: names should exist, be unique and have no cycles.
+func validateDepends(list []*Check) error {
+	named := make(map[string][]*Check)
+	for _, check := range list {
+		if check.Name != "" {
+			named[check.Name] = append(named[check.Name], check)
+		}
+	}
+	for i, check := range list {
+		for _, parent := range check.DependsOn {
+			switch len(named[parent]) {
+			case 0:
+				return errors.New(describe(i, check) + ": depends on unknown check " + parent)
+			case 1:
+			default:
+				return errors.New(describe(i, check) + ": depends on " + parent + ", but the name is not unique")
+			}
+		}
+	}
+	// Depth-first search, keeping the path to report the cycle.
+	done := make(map[string]bool)
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		for i, seen := range path {
+			if seen == name {
+				return errors.New("dependency cycle: " + strings.Join(append(path[i:], name), " -> "))
+			}
+		}
+		if done[name] {
+			return nil
+		}
+		path = append(path, name)
+		for _, parent := range named[name][0].DependsOn {
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		done[name] = true
+		return nil
+	}
+	for name := range named {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Update the unreachable flag and return it.
+func (check *Check) unreachable(unreachable bool) bool {
+	if unreachable != check.Unreachable {
+		mutex.Lock()
+		check.Unreachable = unreachable
+		check.changed(time.Now())
+		mutex.Unlock()
+	}
+	return unreachable
+}
+
+// Resolve the dependencies of the running checks by names.
+func linkDepends(list []*Check) {
+	named := make(map[string]*Check)
+	for _, check := range list {
+		named[check.Name] = check
+	}
+	mutex.Lock()
+	for _, check := range list {
+		check.parents = check.parents[:0]
+		for _, parent := range check.DependsOn {
+			check.parents = append(check.parents, named[parent])
+		}
+	}
+	mutex.Unlock()
+}
+
+// Tells if any of the checks this one depends on is failed. The parents that look fine
+// are probed again, as the check could notice the outage before they do.
+func (check *Check) parentFailed(ctx context.Context) bool {
+	var recheck []*Check
+	mutex.RLock()
+	for _, parent := range check.parents {
+		if parent.Failed || parent.Consecutive > 0 {
+			mutex.RUnlock()
+			return true
+		}
+		recheck = append(recheck, parent)
+	}
+	mutex.RUnlock()
+	for _, parent := range recheck {
+		if parent.reprobe(ctx) != nil && ctx.Err() == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Run the probe out of schedule, without recording the result.
+func (check *Check) reprobe(ctx context.Context) error {
+	sleep := time.Second * time.Duration(check.Sleep)
+	check.probing.Lock()
+	defer check.probing.Unlock()
+	_, _, err := check.probe()(ctx, &sleep)
+	return err
+}
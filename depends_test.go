@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDepends(t *testing.T) {
+	for _, test := range []struct {
+		list []*Check
+		err  string
+	}{
+		{[]*Check{
+			{Name: "Gateway", Ping: "192.168.1.1"},
+			{Name: "Database", TCP: "db:5432", DependsOn: []string{"Gateway"}},
+			{Name: "App", Web: "http://app/", DependsOn: []string{"Database", "Gateway"}},
+			{Web: "http://other/", DependsOn: []string{"App"}},
+		}, ""},
+		{[]*Check{
+			{Name: "App", Web: "http://app/", DependsOn: []string{"Gateway"}},
+		}, "entry 1 (App): depends on unknown check Gateway"},
+		{[]*Check{
+			{Name: "Gateway", Ping: "192.168.1.1"},
+			{Name: "Gateway", Ping: "192.168.2.1"},
+			{Web: "http://app/", DependsOn: []string{"Gateway"}},
+		}, "entry 3 (http://app/): depends on Gateway, but the name is not unique"},
+		{[]*Check{
+			{Name: "App", Web: "http://app/", DependsOn: []string{"App"}},
+		}, "dependency cycle: App -> App"},
+		{[]*Check{
+			{Name: "A", Shell: "true", DependsOn: []string{"B"}},
+			{Name: "B", Shell: "true", DependsOn: []string{"C"}},
+			{Name: "C", Shell: "true", DependsOn: []string{"A"}},
+		}, "dependency cycle: "},
+	} {
+		err := validateDepends(test.list)
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("unexpected error: %v", err)
+		case test.err != "" && (err == nil || !strings.HasPrefix(err.Error(), test.err)):
+			t.Errorf("expected error %q, got %v", test.err, err)
+		}
+	}
+}
+
+func TestDependencyCycle(t *testing.T) {
+	list := []*Check{
+		{Name: "A", Shell: "true", DependsOn: []string{"B"}},
+		{Name: "B", Shell: "true", DependsOn: []string{"A"}},
+		{Name: "C", Shell: "true", DependsOn: []string{"A"}},
+	}
+	err := validateDepends(list)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	// Starts from any check, but only the ones in the cycle are listed.
+	if cycle := err.Error(); cycle != "dependency cycle: A -> B -> A" && cycle != "dependency cycle: B -> A -> B" {
+		t.Errorf("unexpected error: %s", cycle)
+	}
+}
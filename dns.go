@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// Record types the DNS check could query.
+var recordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV"}
+var queryTypes = map[string]dnsmessage.Type{
+	"A": dnsmessage.TypeA, "AAAA": dnsmessage.TypeAAAA, "CNAME": dnsmessage.TypeCNAME,
+	"MX": dnsmessage.TypeMX, "TXT": dnsmessage.TypeTXT, "SRV": dnsmessage.TypeSRV,
+}
+
+// How long to wait for the server's response if the check has no timeout.
+const dnsTimeout = 5 * time.Second
+
+// DNS worker.
+func (check *Check) dns(ctx context.Context, sleep *time.Duration) (out []byte, took time.Duration, err error) {
+	// Resolve in N attempts.
+	took, err = check.try(ctx, sleep, func() (err error) {
+		var answers []string
+		answers, err = check.resolve(ctx)
+		out = nil
+		if len(answers) != 0 {
+			out = []byte(strings.Join(answers, "\n") + "\n")
+		}
+		return
+	})
+	return
+}
+
+// Check the DNS settings.
+func (check *Check) validateDNS() error {
+	if check.DNS == "" {
+		if check.Server != "" || check.Record != "" || check.Expect != "" {
+			return errors.New("server, record and expect are only for DNS checks")
+		}
+		return nil
+	}
+	if check.Record == "" {
+		check.Record = "A"
+	}
+	check.Record = strings.ToUpper(check.Record)
+	known := false
+	for _, record := range recordTypes {
+		known = known || record == check.Record
+	}
+	if !known {
+		return errors.New("record should be one of " + strings.Join(recordTypes, ", ") + ", not " + check.Record)
+	}
+	if check.Server != "" {
+		_, _, err := net.SplitHostPort(check.Server)
+		return err
+	}
+	return nil
+}
+
+// Query the records and check the answers.
+func (check *Check) resolve(ctx context.Context) ([]string, error) {
+	ctx, cancel := check.context(ctx)
+	defer cancel()
+	answers, err := check.lookup(ctx)
+	var dnsErr *net.DNSError
+	switch {
+	case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+		return nil, errors.New(check.DNS + ": no " + check.Record + " records")
+	case err != nil && ctx.Err() != nil:
+		return nil, check.timedOut(ctx)
+	case errors.As(err, &dnsErr):
+		return nil, errors.New(check.DNS + ": failed to resolve: " + dnsErr.Err)
+	case err != nil:
+		return nil, errors.New(check.DNS + ": " + err.Error())
+	case len(answers) == 0:
+		return nil, errors.New(check.DNS + ": no " + check.Record + " records")
+	}
+	if check.Expect != "" && !expected(answers, check.Expect) {
+		return answers, errors.New(check.DNS + ": " + check.Expect + " not found in " + check.Record + " records")
+	}
+	if check.regex != nil && !check.regex.MatchString(strings.Join(answers, "\n")) {
+		return answers, errors.New(check.DNS + ": " + check.Record + " records don't match " + check.Match)
+	}
+	return answers, nil
+}
+
+// Query the server for the records, formatted like dig does.
+func (check *Check) lookup(ctx context.Context) (answers []string, err error) {
+	if check.Server != "" { // Ask the server directly, skipping the hosts file.
+		return check.query(ctx)
+	}
+	resolver := net.DefaultResolver
+	switch check.Record {
+	case "A", "AAAA":
+		network := "ip4"
+		if check.Record == "AAAA" {
+			network = "ip6"
+		}
+		var ips []net.IP
+		ips, err = resolver.LookupIP(ctx, network, check.DNS)
+		for _, ip := range ips {
+			answers = append(answers, ip.String())
+		}
+	case "CNAME":
+		var cname string
+		cname, err = resolver.LookupCNAME(ctx, check.DNS)
+		// A name with no CNAME record resolves to itself.
+		if cname != "" && !strings.EqualFold(strings.TrimSuffix(cname, "."), strings.TrimSuffix(check.DNS, ".")) {
+			answers = append(answers, cname)
+		}
+	case "MX":
+		var records []*net.MX
+		records, err = resolver.LookupMX(ctx, check.DNS)
+		for _, mx := range records {
+			answers = append(answers, formatMX(mx.Pref, mx.Host))
+		}
+	case "TXT":
+		answers, err = resolver.LookupTXT(ctx, check.DNS)
+	case "SRV":
+		var records []*net.SRV
+		_, records, err = resolver.LookupSRV(ctx, "", "", check.DNS)
+		for _, srv := range records {
+			answers = append(answers, formatSRV(srv.Priority, srv.Weight, srv.Port, srv.Target))
+		}
+	}
+	return
+}
+
+// Send the query to the check's server, over TCP if the UDP answer is truncated.
+func (check *Check) query(ctx context.Context) ([]string, error) {
+	name, err := dnsmessage.NewName(strings.TrimSuffix(check.DNS, ".") + ".")
+	if err != nil {
+		return nil, err
+	}
+	qtype := queryTypes[check.Record]
+	random.Lock()
+	id := uint16(random.Intn(1 << 16))
+	random.Unlock()
+	msg := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: name, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	request, err := msg.Pack()
+	if err != nil {
+		return nil, err
+	}
+	var parser dnsmessage.Parser
+	var header dnsmessage.Header
+	for _, network := range []string{"udp", "tcp"} {
+		response, err := exchangeDNS(ctx, network, check.Server, request, id)
+		if err != nil {
+			return nil, err
+		}
+		if header, err = parser.Start(response); err != nil {
+			return nil, err
+		}
+		if !header.Truncated {
+			break
+		}
+	}
+	switch header.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return nil, &net.DNSError{Err: "no such host", Name: check.DNS, Server: check.Server, IsNotFound: true}
+	case dnsmessage.RCodeServerFailure:
+		return nil, &net.DNSError{Err: "server misbehaving", Name: check.DNS, Server: check.Server}
+	case dnsmessage.RCodeRefused:
+		return nil, &net.DNSError{Err: "query refused", Name: check.DNS, Server: check.Server}
+	default:
+		return nil, &net.DNSError{Err: "server error code " + strconv.Itoa(int(header.RCode)),
+			Name: check.DNS, Server: check.Server}
+	}
+	if err = parser.SkipAllQuestions(); err != nil {
+		return nil, err
+	}
+	var answers []string
+	for {
+		rr, err := parser.AnswerHeader()
+		if err == dnsmessage.ErrSectionDone {
+			return answers, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The server may add the CNAME chain for other types.
+		if rr.Type != qtype || rr.Class != dnsmessage.ClassINET {
+			if err = parser.SkipAnswer(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var answer string
+		switch qtype {
+		case dnsmessage.TypeA:
+			var record dnsmessage.AResource
+			record, err = parser.AResource()
+			answer = net.IP(record.A[:]).String()
+		case dnsmessage.TypeAAAA:
+			var record dnsmessage.AAAAResource
+			record, err = parser.AAAAResource()
+			answer = net.IP(record.AAAA[:]).String()
+		case dnsmessage.TypeCNAME:
+			var record dnsmessage.CNAMEResource
+			record, err = parser.CNAMEResource()
+			answer = record.CNAME.String()
+		case dnsmessage.TypeMX:
+			var record dnsmessage.MXResource
+			record, err = parser.MXResource()
+			answer = formatMX(record.Pref, record.MX.String())
+		case dnsmessage.TypeTXT:
+			var record dnsmessage.TXTResource
+			record, err = parser.TXTResource()
+			answer = strings.Join(record.TXT, "") // Joined like the system resolver does.
+		case dnsmessage.TypeSRV:
+			var record dnsmessage.SRVResource
+			record, err = parser.SRVResource()
+			answer = formatSRV(record.Priority, record.Weight, record.Port, record.Target.String())
+		}
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, answer)
+	}
+}
+
+// Send the DNS request and read the response with the same ID.
+func exchangeDNS(ctx context.Context, network string, server string, request []byte, id uint16) ([]byte, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(dnsTimeout)
+	}
+	conn.SetDeadline(deadline)
+	go func() { // Interrupt when stopped.
+		<-ctx.Done()
+		conn.Close()
+	}()
+	if network == "tcp" { // Messages are prefixed with the length.
+		data := make([]byte, 2, 2+len(request))
+		binary.BigEndian.PutUint16(data, uint16(len(request)))
+		if _, err = conn.Write(append(data, request...)); err != nil {
+			return nil, err
+		}
+		if _, err = io.ReadFull(conn, data); err != nil {
+			return nil, err
+		}
+		response := make([]byte, binary.BigEndian.Uint16(data))
+		_, err = io.ReadFull(conn, response)
+		return response, err
+	}
+	if _, err = conn.Write(request); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 65535)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		// Skip the stray and spoofed responses.
+		if n >= 2 && binary.BigEndian.Uint16(buf) == id {
+			return buf[:n], nil
+		}
+	}
+}
+
+func formatMX(pref uint16, host string) string {
+	return strconv.Itoa(int(pref)) + " " + host
+}
+
+func formatSRV(priority uint16, weight uint16, port uint16, target string) string {
+	return strconv.Itoa(int(priority)) + " " + strconv.Itoa(int(weight)) + " " + strconv.Itoa(int(port)) + " " + target
+}
+
+// Tells if one of the answers is the expected one. Names match with or without the trailing dot.
+func expected(answers []string, expect string) bool {
+	expect = strings.TrimSuffix(expect, ".")
+	for _, answer := range answers {
+		if strings.EqualFold(strings.TrimSuffix(answer, "."), expect) {
+			return true
+		}
+	}
+	return false
+}
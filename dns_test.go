@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+// Record types by their numbers in DNS messages.
+const (
+	typeA     = 1
+	typeCNAME = 5
+	typeMX    = 15
+	typeTXT   = 16
+	typeAAAA  = 28
+	typeSRV   = 33
+)
+
+type question struct {
+	name  string
+	qtype uint16
+}
+
+// Records served by the stub resolver. Unknown names get NXDOMAIN.
+var zone = map[question][][]byte{
+	{"app.test", typeA}:             {net.ParseIP("10.0.0.10").To4(), net.ParseIP("10.0.0.11").To4()},
+	{"app.test", typeAAAA}:          {net.ParseIP("fd00::10")},
+	{"app.test", typeMX}:            {append([]byte{0, 10}, wireName("mx.app.test")...)},
+	{"app.test", typeTXT}:           {append([]byte{11}, "hello world"...)},
+	{"www.test", typeCNAME}:         {wireName("app.test")},
+	{"_sip._tcp.app.test", typeSRV}: {append([]byte{0, 1, 0, 5, 0x13, 0xc4}, wireName("sip.app.test")...)},
+	{"empty.test", typeA}:           nil,
+	// Truncated over UDP.
+	{"big.test", typeTXT}: {append([]byte{8}, "over tcp"...)},
+}
+
+// Name encoded as labels.
+func wireName(name string) []byte {
+	var data []byte
+	for _, label := range strings.Split(name, ".") {
+		data = append(data, byte(len(label)))
+		data = append(data, label...)
+	}
+	return append(data, 0)
+}
+
+// Start the stub resolver on UDP and TCP, returns its address.
+func stubDNS(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	conn, err := net.ListenPacket("udp", listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if resp := answer(buf[:n], false); resp != nil {
+				conn.WriteTo(resp, addr)
+			}
+		}
+	}()
+	go func() {
+		for {
+			stream, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			size := make([]byte, 2)
+			if _, err = io.ReadFull(stream, size); err == nil {
+				query := make([]byte, binary.BigEndian.Uint16(size))
+				if _, err = io.ReadFull(stream, query); err == nil {
+					resp := answer(query, true)
+					binary.BigEndian.PutUint16(size, uint16(len(resp)))
+					stream.Write(append(size, resp...))
+				}
+			}
+			stream.Close()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// Build the response to the query.
+func answer(query []byte, tcp bool) []byte {
+	if len(query) < 12 {
+		return nil
+	}
+	var labels []string
+	i := 12
+	for i < len(query) && query[i] != 0 {
+		end := i + 1 + int(query[i])
+		if end > len(query) {
+			return nil
+		}
+		labels = append(labels, string(query[i+1:end]))
+		i = end
+	}
+	if i+5 > len(query) {
+		return nil
+	}
+	q := question{strings.ToLower(strings.Join(labels, ".")), binary.BigEndian.Uint16(query[i+1:])}
+	var flags uint16 = 0x8180 // Response, recursion desired and available.
+	records, found := zone[q]
+	switch {
+	case q.name == "fail.test":
+		flags |= 2 // SERVFAIL
+	case !found && !known(q.name):
+		flags |= 3 // NXDOMAIN
+	case q.name == "big.test" && !tcp:
+		flags |= 0x200 // Truncated.
+		records = nil
+	}
+	resp := make([]byte, 12, 512)
+	copy(resp, query[:2])
+	binary.BigEndian.PutUint16(resp[2:], flags)
+	binary.BigEndian.PutUint16(resp[4:], 1)
+	binary.BigEndian.PutUint16(resp[6:], uint16(len(records)))
+	resp = append(resp, query[12:i+5]...)
+	for _, data := range records {
+		// Pointer to the name in the question, type, class IN, TTL, data.
+		record := []byte{0xc0, 12, 0, 0, 0, 1, 0, 0, 0, 60, 0, 0}
+		binary.BigEndian.PutUint16(record[2:], q.qtype)
+		binary.BigEndian.PutUint16(record[10:], uint16(len(data)))
+		resp = append(append(resp, record...), data...)
+	}
+	return resp
+}
+
+// Tells if the name has any records.
+func known(name string) bool {
+	for q := range zone {
+		if q.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDNS(t *testing.T) {
+	server := stubDNS(t)
+	for _, test := range []struct {
+		check  *Check
+		answer string
+		err    string
+	}{
+		{&Check{DNS: "app.test"}, "10.0.0.10\n10.0.0.11", ""},
+		{&Check{DNS: "app.test", Record: "aaaa"}, "fd00::10", ""},
+		{&Check{DNS: "app.test", Record: "MX"}, "10 mx.app.test.", ""},
+		{&Check{DNS: "app.test", Record: "TXT"}, "hello world", ""},
+		{&Check{DNS: "www.test", Record: "CNAME"}, "app.test.", ""},
+		{&Check{DNS: "_sip._tcp.app.test", Record: "SRV"}, "1 5 5060 sip.app.test.", ""},
+		{&Check{DNS: "app.test", Expect: "10.0.0.11"}, "10.0.0.10\n10.0.0.11", ""},
+		{&Check{DNS: "app.test", Record: "MX", Expect: "10 MX.app.test"}, "10 mx.app.test.", ""},
+		{&Check{DNS: "app.test", Expect: "10.0.0.12"}, "10.0.0.10\n10.0.0.11",
+			"app.test: 10.0.0.12 not found in A records"},
+		{&Check{DNS: "app.test", Record: "TXT", Match: "^hello"}, "hello world", ""},
+		{&Check{DNS: "app.test", Record: "TXT", Match: "^world"}, "hello world",
+			"app.test: TXT records don't match ^world"},
+		{&Check{DNS: "empty.test"}, "", "empty.test: no A records"},
+		{&Check{DNS: "app.test", Record: "CNAME"}, "", "app.test: no CNAME records"},
+		{&Check{DNS: "missing.test"}, "", "missing.test: no A records"},
+		{&Check{DNS: "fail.test"}, "", "fail.test: failed to resolve: "},
+		{&Check{DNS: "big.test", Record: "TXT"}, "over tcp", ""},
+		// In the hosts file, but not known to the server.
+		{&Check{DNS: "localhost"}, "", "localhost: no A records"},
+	} {
+		check := test.check
+		check.Server = server
+		check.Timeout = 5
+		if err := check.prepare(); err != nil {
+			t.Errorf("%s %s: %v", check.DNS, check.Record, err)
+			continue
+		}
+		answers, err := check.resolve(context.Background())
+		if answer := strings.Join(answers, "\n"); answer != test.answer {
+			t.Errorf("%s %s: expected %q, got %q", check.DNS, check.Record, test.answer, answer)
+		}
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s %s: unexpected error: %v", check.DNS, check.Record, err)
+		case test.err != "" && (err == nil || !strings.HasPrefix(err.Error(), test.err)):
+			t.Errorf("%s %s: expected error %q, got %v", check.DNS, check.Record, test.err, err)
+		}
+	}
+}
+
+func TestValidateDNS(t *testing.T) {
+	for _, check := range []*Check{
+		{DNS: "app.test", Record: "PTR"},
+		{DNS: "app.test", Server: "127.0.0.1"},
+		{TCP: "localhost:22", Record: "A"},
+	} {
+		if err := check.validateDNS(); err == nil {
+			t.Errorf("%+v: expected an error", check)
+		}
+	}
+}
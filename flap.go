@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Flap detection: a check is flapping when it changes state
+// more than the number of times within the window.
+type flapDetection struct {
+	Changes int
+	Window  duration
+}
+
+// Check the settings.
+func (flap *flapDetection) validate() error {
+	if flap.Changes <= 0 {
+		return errors.New("flap: changes should be positive")
+	}
+	if flap.Window <= 0 {
+		return errors.New("flap: window should be positive")
+	}
+	return nil
+}
+
+// Forget state changes that are out of the window.
+func (check *Check) prune(ts time.Time) {
+	start := ts.Add(-time.Duration(check.Flap.Window))
+	i := 0
+	for i < len(check.changes) && check.changes[i].Before(start) {
+		i++
+	}
+	check.changes = check.changes[i:]
+}
+
+// Track the state change. Tells if the check is flapping,
+// sending one notification when it starts to.
+func (check *Check) flap(name *string, ts time.Time, silenced bool) bool {
+	if check.Flap == nil {
+		return false
+	}
+	check.prune(ts)
+	check.changes = append(check.changes, ts)
+	if check.Flapping {
+		return true
+	}
+	if len(check.changes) <= check.Flap.Changes {
+		return false
+	}
+	mutex.Lock()
+	check.Flapping = true
+	check.changed(ts)
+	mutex.Unlock()
+	msg := "Changed state " + strconv.Itoa(len(check.changes)) + " times in " +
+		time.Duration(check.Flap.Window).String()
+	check.report("Flapping", name, &msg, silenced)
+	return true
+}
+
+// The flapping check is stable again once it changes state
+// no more than half the number of times within the window.
+func (check *Check) settle(name *string, silenced bool) {
+	if !check.Flapping {
+		return
+	}
+	ts := time.Now()
+	check.prune(ts)
+	if len(check.changes) > check.Flap.Changes/2 {
+		return
+	}
+	mutex.Lock()
+	check.Flapping = false
+	check.changed(ts)
+	mutex.Unlock()
+	msg := "Now ok"
+	if check.Failed {
+		msg = "Now failed"
+	}
+	check.report("Stable", name, &msg, silenced)
+}
@@ -3,6 +3,8 @@ module github.com/chillum/jsonmon
 go 1.18
 
 require (
+	golang.org/x/crypto v0.17.0
+	golang.org/x/net v0.19.0
 	golang.org/x/sys v0.15.0
 	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+// Default number of results to keep per check.
+const historyDepth = 100
+
+// Messages longer than that are truncated in history.
+const messageLimit = 512
+
+// Probe output longer than that is truncated in the check's details.
+const outputLimit = 4096
+
+// Probe result kept in the check's history.
+type result struct {
+	Time     string `json:"time"`
+	Failed   bool   `json:"failed"`
+	Duration int64  `json:"duration_ms"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Details of a probe run, shown by /status/{id}.
+type detail struct {
+	Time     string `json:"time"`
+	Failed   bool   `json:"failed"`
+	Error    string `json:"error,omitempty"`
+	Output   string `json:"output,omitempty"`
+	Duration int64  `json:"duration_ms"`
+	Attempts int    `json:"attempts"`
+}
+
+// Fixed size ring of recent results.
+type results struct {
+	list []result
+	next int
+	full bool
+}
+
+func newResults(depth int) *results {
+	if depth == 0 {
+		depth = historyDepth
+	}
+	return &results{list: make([]result, depth)}
+}
+
+// Add the result, overwriting the oldest one if the ring is full.
+func (ring *results) add(entry result) {
+	ring.list[ring.next] = entry
+	ring.next++
+	if ring.next == len(ring.list) {
+		ring.next = 0
+		ring.full = true
+	}
+}
+
+// Results from the oldest to the newest.
+func (ring *results) MarshalJSON() ([]byte, error) {
+	if ring == nil {
+		return []byte("[]"), nil
+	}
+	ordered := make([]result, 0, len(ring.list))
+	if ring.full {
+		ordered = append(ordered, ring.list[ring.next:]...)
+	}
+	ordered = append(ordered, ring.list[:ring.next]...)
+	return json.Marshal(ordered)
+}
+
+// Record the probe's result in history and stats.
+func (check *Check) record(out []byte, err error, took time.Duration) {
+	ts := time.Now()
+	entry := result{Time: ts.Format(time.RFC3339), Duration: took.Milliseconds()}
+	last := &detail{Time: entry.Time, Output: truncate(string(out), outputLimit),
+		Duration: entry.Duration, Attempts: check.attempts}
+	if err != nil {
+		entry.Failed = true
+		entry.Message = truncate(string(out)+err.Error(), messageLimit)
+		last.Failed = true
+		last.Error = truncate(err.Error(), outputLimit)
+	}
+	mutex.Lock()
+	check.results.add(entry)
+	check.last = last
+	if err != nil {
+		check.lastError = last
+	}
+	check.changed(ts)
+	check.runs++
+	check.Duration = took.Milliseconds()
+	if err != nil {
+		check.failures++
+		check.Consecutive++
+		check.passing = 0
+	} else {
+		check.Consecutive = 0
+		check.passing++
+	}
+	mutex.Unlock()
+}
+
+// Bump the check's ETag along with the global one. Call with the mutex locked.
+func (check *Check) changed(ts time.Time) {
+	check.updated = etag(ts)
+	modified = check.updated
+}
+
+// Cut the string to the limit, not breaking UTF-8 characters.
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit] + "..."
+}
+
+// Find the check by ID.
+func findCheck(id string) *Check {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	for _, check := range checks {
+		if check.ID == id {
+			return check
+		}
+	}
+	return nil
+}
+
+// Display check's recent results.
+func getHistory(w http.ResponseWriter, r *http.Request) {
+	check := findCheck(r.URL.Query().Get("check"))
+	if check == nil {
+		http.NotFound(w, r)
+		return
+	}
+	displayJSON(w, r, check.results, &check.updated, true)
+}
@@ -3,7 +3,8 @@ Quick and simple monitoring system
 
 Usage:
 
-	jsonmon [-syslog] config.yml
+	jsonmon [-syslog] [-state state.json] config.yml
+	jsonmon -check config.yml
 	jsonmon -version
 
 Docs:
@@ -23,8 +24,6 @@ import (
 	"sync"
 	"syscall"
 	"time"
-
-	"gopkg.in/yaml.v2"
 )
 
 // Version is the application version.
@@ -41,7 +40,7 @@ type ver struct {
 var version ver
 
 // Global checks list. Need to share it with workers and Web UI.
-var checks []Check
+var checks []*Check
 var mutex *sync.RWMutex
 
 // Global started and last modified date for HTTP caching.
@@ -53,16 +52,20 @@ var modJS string
 var modCSS string
 
 var useSyslog *bool
+var stateFile *string
 
 // The main loop.
 func main() {
 	var err error
 	// Parse CLI args.
 	cliVersion := flag.Bool("version", false, "")
+	cliCheck := flag.Bool("check", false, "")
 	useSyslog = flag.Bool("syslog", false, "")
+	stateFile = flag.String("state", "", "")
 	flag.Usage = func() {
 		fmt.Fprint(os.Stderr,
-			"Usage: jsonmon [-syslog] config.yml\n",
+			"Usage: jsonmon [-syslog] [-state state.json] config.yml\n",
+			"       jsonmon -check config.yml\n",
 			"       jsonmon -version\n",
 			"----------------------------------------------\n",
 			"Docs:  https://github.com/chillum/jsonmon/wiki\n")
@@ -88,6 +91,14 @@ func main() {
 		flag.Usage()
 	}
 
+	// -check validates the config and exits.
+	if *cliCheck {
+		if !checkConfig(args[0]) {
+			os.Exit(3)
+		}
+		os.Exit(0)
+	}
+
 	// Initialize system log.
 	if *useSyslog {
 		logs, err = logInit()
@@ -98,32 +109,55 @@ func main() {
 	}
 
 	// Parse the config file or exit with error.
-	config, err := os.ReadFile(args[0])
+	conf, err := loadConfig(args[0], false)
 	if err != nil {
 		log(2, err.Error())
 		os.Exit(3)
 	}
-	err = yaml.Unmarshal(config, &checks)
-	if err != nil {
-		log(2, "invalid config at "+os.Args[1]+"\n"+err.Error())
-		os.Exit(3)
-	}
+	checks = conf.Checks
+	// The signal handler may use it right away.
+	mutex = &sync.RWMutex{}
 
-	// Exit with return code 0 on kill.
+	// Shut down gracefully and exit with return code 0 on kill.
+	server := &http.Server{}
+	stopped := make(chan struct{})
 	done := make(chan os.Signal, 1)
-	signal.Notify(done, syscall.SIGTERM)
+	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
 		<-done
-		os.Exit(0)
+		shutdown(server)
+		close(stopped)
 	}()
 
 	// Run checks and init HTTP cache.
 	started = etag(time.Now())
 	modified = started
-	mutex = &sync.RWMutex{}
-	for i := range checks {
-		go checks[i].Run()
+	modSilences = started
+	conf.apply()
+	linkDepends(checks)
+	if *stateFile != "" {
+		err = loadState(*stateFile)
+		if err != nil {
+			log(3, "Failed to load state, starting from scratch: "+err.Error())
+		}
+	}
+	for _, check := range checks {
+		if err := check.prepare(); err != nil {
+			check.disable(err)
+			continue
+		}
+		check.start()
 	}
+
+	// Reload the config on SIGHUP.
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			reload(args[0])
+		}
+	}()
+
 	cacheHTML, _ := AssetInfo("index.html")
 	modHTML = cacheHTML.ModTime().UTC().Format(http.TimeFormat)
 	cacheAngular, _ := AssetInfo("angular.min.js")
@@ -145,14 +179,29 @@ func main() {
 	listen := host + ":" + port
 
 	http.HandleFunc("/status", getChecks)
+	http.HandleFunc("/status/", getCheck)
+	http.HandleFunc("/history", getHistory)
+	http.HandleFunc("/metrics", getMetrics)
+	http.HandleFunc("/silences", handleSilences)
+	http.HandleFunc("/silences/", handleSilences)
 	http.HandleFunc("/version", getVersion)
 	http.HandleFunc("/", getUI)
 
-	log(7, "Starting HTTP service at "+listen)
-	err = http.ListenAndServe(listen, nil)
-	if err != nil {
-		log(2, err.Error())
-		log(7, "Use HOST and PORT env variables to customize server settings")
+	server.Addr = listen
+	server.Handler = protect(http.DefaultServeMux)
+	if conf.Server != nil && conf.Server.tls != nil {
+		log(7, "Starting HTTPS service at "+listen)
+		server.TLSConfig = conf.Server.tls
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		log(7, "Starting HTTP service at "+listen)
+		err = server.ListenAndServe()
+	}
+	if err == http.ErrServerClosed {
+		<-stopped
+		os.Exit(0)
 	}
+	log(2, err.Error())
+	log(7, "Use HOST and PORT env variables to customize server settings")
 	os.Exit(4)
 }
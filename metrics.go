@@ -0,0 +1,82 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Export checks' metrics in Prometheus text format.
+func getMetrics(w http.ResponseWriter, r *http.Request) {
+	var out strings.Builder
+	describeMetric(&out, "jsonmon_build_info", "gauge", "jsonmon version and build details.")
+	out.WriteString("jsonmon_build_info{version=" + label(version.App) +
+		",goversion=" + label(version.Go) + ",os=" + label(version.Os) +
+		",arch=" + label(version.Arch) + "} 1\n")
+
+	metrics := []struct {
+		name  string
+		kind  string
+		help  string
+		value func(check *Check) (float64, bool)
+	}{
+		{"jsonmon_check_up", "gauge", "Whether the check succeeds.",
+			func(check *Check) (float64, bool) {
+				if check.Failed {
+					return 0, true
+				}
+				return 1, true
+			}},
+		{"jsonmon_check_duration_seconds", "gauge", "How long the last run took.",
+			func(check *Check) (float64, bool) {
+				return float64(check.Duration) / 1000, check.runs != 0
+			}},
+		{"jsonmon_check_last_transition_timestamp_seconds", "gauge", "When the check changed its state.",
+			func(check *Check) (float64, bool) {
+				since, err := time.Parse(time.RFC3339, check.Since)
+				return float64(since.Unix()), err == nil
+			}},
+		{"jsonmon_check_consecutive_failures", "gauge", "Failed runs in a row.",
+			func(check *Check) (float64, bool) {
+				return float64(check.Consecutive), true
+			}},
+		{"jsonmon_check_runs_total", "counter", "Runs since start.",
+			func(check *Check) (float64, bool) {
+				return float64(check.runs), true
+			}},
+		{"jsonmon_check_failures_total", "counter", "Failed runs since start.",
+			func(check *Check) (float64, bool) {
+				return float64(check.failures), true
+			}},
+	}
+	mutex.RLock()
+	for _, metric := range metrics {
+		describeMetric(&out, metric.name, metric.kind, metric.help)
+		for _, check := range checks {
+			if value, ok := metric.value(check); ok {
+				out.WriteString(metric.name + "{id=" + label(check.ID) + ",name=" + label(check.title()) +
+					",type=" + label(check.kind()) + "} " +
+					strconv.FormatFloat(value, 'f', -1, 64) + "\n")
+			}
+		}
+	}
+	mutex.RUnlock()
+
+	h := w.Header()
+	h.Set("Server", "jsonmon")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write([]byte(out.String()))
+}
+
+// Write metric's HELP and TYPE lines.
+func describeMetric(out *strings.Builder, name string, kind string, help string) {
+	out.WriteString("# HELP " + name + " " + help + "\n")
+	out.WriteString("# TYPE " + name + " " + kind + "\n")
+}
+
+// Quote and escape the label value.
+func label(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
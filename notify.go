@@ -1,35 +1,86 @@
 package main
 
 import (
-	"io"
+	"errors"
+	"mime"
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// Mail delivery attempts and the delay between them, multiplied by attempt.
+const mailTries = 3
+const mailRetry = 10 * time.Second
+
 // Mail notifications.
 func notify(to *string, subject *string, message *string) {
+	mutex.RLock()
+	conf := mail
+	mutex.RUnlock()
 	// Make the message.
 	var msg strings.Builder
-	var err error
+	if conf != nil {
+		msg.WriteString("From: ")
+		msg.WriteString(conf.From)
+		msg.WriteString("\n")
+	}
 	msg.WriteString("To: ")
 	msg.WriteString(*to)
 	msg.WriteString("\nSubject: ")
-	msg.WriteString(*subject)
+	msg.WriteString(mime.QEncoding.Encode("utf-8", *subject))
+	msg.WriteString("\nDate: ")
+	msg.WriteString(time.Now().Format(time.RFC1123Z))
+	msg.WriteString("\nMessage-ID: ")
+	msg.WriteString(messageID())
+	msg.WriteString("\nMIME-Version: 1.0")
+	msg.WriteString("\nContent-Type: text/plain; charset=utf-8")
+	msg.WriteString("\nContent-Transfer-Encoding: 8bit")
 	msg.WriteString("\nX-Mailer: jsonmon\n\n")
 	if message != nil {
 		msg.WriteString(*message)
 	}
-	msg.WriteString("\n.\n")
+	msg.WriteString("\n")
 	// And send it.
-	sendmail := exec.Command("/usr/sbin/sendmail", "-t")
-	stdin, _ := sendmail.StdinPipe()
-	err = sendmail.Start()
+	for i := 1; ; i++ {
+		var err error
+		if conf != nil {
+			err = conf.send(*to, msg.String())
+		} else {
+			err = sendmail(msg.String())
+		}
+		if err == nil {
+			return
+		}
+		log(3, "Mail to "+*to+" failed, attempt "+strconv.Itoa(i)+" of "+
+			strconv.Itoa(mailTries)+"\n"+err.Error())
+		if i == mailTries {
+			return
+		}
+		time.Sleep(time.Duration(i) * mailRetry)
+	}
+}
+
+// Unique Message-ID header value.
+func messageID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	return "<" + strconv.FormatInt(time.Now().UnixNano(), 36) + "." +
+		strconv.Itoa(os.Getpid()) + ".jsonmon@" + hostname + ">"
+}
+
+// Fallback transport when SMTP is not configured.
+func sendmail(msg string) error {
+	cmd := exec.Command("/usr/sbin/sendmail", "-t", "-i")
+	cmd.Stdin = strings.NewReader(msg)
+	out, err := cmd.CombinedOutput()
 	if err != nil {
-		log(3, err.Error())
+		return errors.New(string(out) + err.Error())
 	}
-	io.WriteString(stdin, msg.String())
-	sendmail.Wait()
+	return nil
 }
 
 // Executes callback. Passes args: true/false, check's name, message.
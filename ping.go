@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+// Packets sent by default, how long to wait for each reply and between the requests.
+const pingCount = 3
+const pingWait = time.Second
+
+// Echo request ID and sequence, unique per process.
+var echoID = os.Getpid() & 0xffff
+var echoSeq = struct {
+	sync.Mutex
+	next int
+}{}
+
+// Ping worker.
+func (check *Check) ping(ctx context.Context, sleep *time.Duration) ([]byte, time.Duration, error) {
+	// Ping in N attempts.
+	took, err := check.try(ctx, sleep, func() error {
+		return check.echo(ctx)
+	})
+	return nil, took, err
+}
+
+// Check the ping settings.
+func (check *Check) validatePing() error {
+	if check.Ping == "" {
+		if check.Count != 0 || check.MaxLoss != 0 || check.MaxRTT != 0 {
+			return errors.New("count, max_loss and max_rtt are only for ping checks")
+		}
+		return nil
+	}
+	if check.Count < 0 {
+		return errors.New("count can't be negative")
+	}
+	if check.Count == 0 {
+		check.Count = pingCount
+	}
+	// The timeout is for the whole probe, the requests are pingWait apart.
+	if check.Timeout > 0 && time.Duration(check.Timeout)*time.Second <= time.Duration(check.Count-1)*pingWait {
+		return errors.New("timeout should be more than " + (time.Duration(check.Count-1) * pingWait).String() +
+			" to send " + strconv.Itoa(check.Count) + " packets")
+	}
+	if check.MaxLoss < 0 || check.MaxLoss > 100 {
+		return errors.New("max_loss should be 0 to 100 percent")
+	}
+	if check.MaxRTT < 0 {
+		return errors.New("max_rtt can't be negative")
+	}
+	return nil
+}
+
+// Send the echo requests, check the loss and RTT.
+func (check *Check) echo(ctx context.Context) error {
+	ctx, cancel := check.context(ctx)
+	defer cancel()
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, check.Ping)
+	if err != nil && ctx.Err() != nil {
+		return check.timedOut(ctx)
+	}
+	if err != nil {
+		return dialError(check.Ping, err, pingWait)
+	}
+	dest := ips[0].IP
+	conn, privileged, err := listenICMP(dest)
+	if err != nil {
+		return errors.New(check.Ping + ": " + err.Error())
+	}
+	defer conn.Close()
+	go func() { // Interrupt reading when stopped.
+		<-ctx.Done()
+		conn.Close()
+	}()
+	var received int
+	var total time.Duration
+	for i := 0; i < check.Count; i++ {
+		sent := time.Now()
+		rtt, err := exchange(ctx, conn, dest, privileged)
+		if ctx.Err() != nil {
+			return check.timedOut(ctx)
+		}
+		if err == nil {
+			received++
+			total += rtt
+		}
+		if i+1 < check.Count {
+			select {
+			case <-ctx.Done():
+				return check.timedOut(ctx)
+			case <-time.After(time.Until(sent.Add(pingWait))):
+			}
+		}
+	}
+	loss := float64(check.Count-received) * 100 / float64(check.Count)
+	var avg time.Duration
+	if received != 0 {
+		avg = total / time.Duration(received)
+	}
+	rtt := float64(avg.Microseconds()) / 1000
+	mutex.Lock()
+	check.Loss = &loss
+	check.RTT = nil
+	if received != 0 {
+		check.RTT = &rtt
+	}
+	mutex.Unlock()
+	percent := strconv.FormatFloat(math.Round(loss), 'f', -1, 64) + "%"
+	switch {
+	case received == 0:
+		return errors.New(check.Ping + ": no reply, 100% packet loss")
+	case loss > float64(check.MaxLoss):
+		return errors.New(check.Ping + ": " + percent + " packet loss, more than " + strconv.Itoa(check.MaxLoss) + "%")
+	case check.MaxRTT > 0 && avg > time.Duration(check.MaxRTT):
+		return errors.New(check.Ping + ": average RTT " + avg.Round(time.Microsecond).String() +
+			", more than " + time.Duration(check.MaxRTT).String())
+	}
+	return nil
+}
+
+// Open an unprivileged ICMP datagram socket, or a raw one if the system doesn't allow it.
+func listenICMP(dest net.IP) (conn *icmp.PacketConn, privileged bool, err error) {
+	network, raw, addr := "udp4", "ip4:icmp", "0.0.0.0"
+	if dest.To4() == nil {
+		network, raw, addr = "udp6", "ip6:ipv6-icmp", "::"
+	}
+	if conn, err = icmp.ListenPacket(network, addr); err == nil {
+		return conn, false, nil
+	}
+	if conn, err = icmp.ListenPacket(raw, addr); err != nil {
+		return nil, false, errors.New("can't open ICMP socket: " + err.Error())
+	}
+	return conn, true, nil
+}
+
+// Send an echo request and wait for the reply.
+func exchange(ctx context.Context, conn *icmp.PacketConn, dest net.IP, privileged bool) (time.Duration, error) {
+	echoSeq.Lock()
+	seq := echoSeq.next
+	echoSeq.next = (seq + 1) & 0xffff
+	echoSeq.Unlock()
+	var request icmp.Type = ipv4.ICMPTypeEcho
+	var reply icmp.Type = ipv4.ICMPTypeEchoReply
+	proto := 1 // ICMP for IPv4.
+	if dest.To4() == nil {
+		request, reply, proto = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply, 58
+	}
+	msg := icmp.Message{Type: request, Body: &icmp.Echo{ID: echoID, Seq: seq, Data: []byte("jsonmon")}}
+	data, err := msg.Marshal(nil)
+	if err != nil {
+		return 0, err
+	}
+	var to net.Addr = &net.UDPAddr{IP: dest}
+	if privileged {
+		to = &net.IPAddr{IP: dest}
+	}
+	sent := time.Now()
+	if _, err = conn.WriteTo(data, to); err != nil {
+		return 0, err
+	}
+	deadline := sent.Add(pingWait)
+	if limit, ok := ctx.Deadline(); ok && limit.Before(deadline) {
+		deadline = limit
+	}
+	conn.SetReadDeadline(deadline)
+	buf := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return 0, err
+		}
+		parsed, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil || parsed.Type != reply {
+			continue
+		}
+		body, ok := parsed.Body.(*icmp.Echo)
+		// The kernel sets the ID for datagram sockets, raw ones get everyone's replies.
+		if !ok || body.Seq != seq || (privileged && body.ID != echoID) || !sameIP(peer, dest) {
+			continue
+		}
+		return time.Since(sent), nil
+	}
+}
+
+// Tells if the packet came from the address.
+func sameIP(peer net.Addr, ip net.IP) bool {
+	switch addr := peer.(type) {
+	case *net.UDPAddr:
+		return addr.IP.Equal(ip)
+	case *net.IPAddr:
+		return addr.IP.Equal(ip)
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cron schedule: minute, hour, day of month, month and day of week.
+type cron struct {
+	minute, hour, dom, month, dow uint64
+	// Day matches either day of month or day of week if both are restricted.
+	domStar, dowStar bool
+}
+
+var months = []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// Day names, also used by maintenance windows.
+var days = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// Day of week by its name, -1 if unknown.
+func weekday(name string) time.Weekday {
+	for i, day := range days {
+		if strings.EqualFold(name, day) {
+			return time.Weekday(i)
+		}
+	}
+	return -1
+}
+
+// Random source for jitter.
+var random = struct {
+	sync.Mutex
+	*rand.Rand
+}{Rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// Parse the standard 5-field cron expression.
+func parseCron(spec string) (*cron, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, errors.New("schedule should have 5 fields: " + spec)
+	}
+	var sched cron
+	var err error
+	if sched.minute, err = cronField(fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if sched.hour, err = cronField(fields[1], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if sched.dom, err = cronField(fields[2], 1, 31, nil); err != nil {
+		return nil, err
+	}
+	if sched.month, err = cronField(fields[3], 1, 12, months); err != nil {
+		return nil, err
+	}
+	if sched.dow, err = cronField(fields[4], 0, 7, days); err != nil {
+		return nil, err
+	}
+	if sched.dow&(1<<7) != 0 { // 7 is Sunday too.
+		sched.dow |= 1
+	}
+	// */2 is unrestricted too, as in Vixie cron.
+	sched.domStar = strings.HasPrefix(fields[2], "*")
+	sched.dowStar = strings.HasPrefix(fields[4], "*")
+	if sched.next(time.Now()).IsZero() {
+		return nil, errors.New("schedule never fires: " + spec)
+	}
+	return &sched, nil
+}
+
+// Parse the cron field into a bit set: lists of *, numbers, names, ranges and steps.
+func cronField(field string, min int, max int, names []string) (bits uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		low, high, step := min, max, 1
+		rng := part
+		if i := strings.Index(part, "/"); i != -1 {
+			rng = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, errors.New("invalid step in schedule: " + part)
+			}
+		}
+		if rng != "*" {
+			bounds := strings.SplitN(rng, "-", 2)
+			if low, err = cronValue(bounds[0], names); err != nil {
+				return
+			}
+			high = low
+			if len(bounds) == 2 {
+				if high, err = cronValue(bounds[1], names); err != nil {
+					return
+				}
+			} else if step != 1 { // N/step means N-max/step.
+				high = max
+			}
+		}
+		if low < min || high > max || low > high {
+			return 0, errors.New("out of range in schedule: " + part)
+		}
+		for i := low; i <= high; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return
+}
+
+// Parse the number or the name in the cron field.
+func cronValue(value string, names []string) (int, error) {
+	for i, name := range names {
+		if name != "" && strings.EqualFold(value, name) {
+			return i, nil
+		}
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.New("invalid value in schedule: " + value)
+	}
+	return number, nil
+}
+
+// The first time matching the schedule after the given one.
+// Zero if there's none within 5 years, like for February 30.
+func (sched *cron) next(ts time.Time) time.Time {
+	ts = ts.Truncate(time.Minute).Add(time.Minute)
+	loc := ts.Location()
+	limit := ts.Year() + 5
+	for ts.Year() <= limit {
+		if sched.month&(1<<uint(ts.Month())) == 0 {
+			ts = time.Date(ts.Year(), ts.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !sched.matchDay(ts) {
+			ts = time.Date(ts.Year(), ts.Month(), ts.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if sched.hour&(1<<uint(ts.Hour())) == 0 {
+			ts = time.Date(ts.Year(), ts.Month(), ts.Day(), ts.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if sched.minute&(1<<uint(ts.Minute())) == 0 {
+			ts = ts.Add(time.Minute)
+			continue
+		}
+		return ts
+	}
+	return time.Time{}
+}
+
+func (sched *cron) matchDay(ts time.Time) bool {
+	dom := sched.dom&(1<<uint(ts.Day())) != 0
+	dow := sched.dow&(1<<uint(ts.Weekday())) != 0
+	if sched.domStar || sched.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Random delay up to the check's jitter.
+func (check *Check) jitter() time.Duration {
+	if check.Jitter <= 0 {
+		return 0
+	}
+	random.Lock()
+	defer random.Unlock()
+	return time.Duration(random.Int63n(int64(check.Jitter)))
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronErrors(t *testing.T) {
+	for _, spec := range []string{
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * foo *",
+		"0 0 30 feb *",
+	} {
+		if _, err := parseCron(spec); err == nil {
+			t.Errorf("%q: expected an error", spec)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// Wednesday.
+	from := time.Date(2025, time.January, 1, 10, 30, 20, 0, time.UTC)
+	for _, test := range []struct {
+		spec string
+		next string
+	}{
+		{"* * * * *", "2025-01-01 10:31"},
+		{"*/15 * * * *", "2025-01-01 10:45"},
+		{"0 * * * *", "2025-01-01 11:00"},
+		{"30 10 * * *", "2025-01-02 10:30"},
+		{"0 9-17/4 * * *", "2025-01-01 13:00"},
+		{"0 0 1 * *", "2025-02-01 00:00"},
+		{"0 0 * * mon", "2025-01-06 00:00"},
+		{"0 0 * * 7", "2025-01-05 00:00"},
+		{"0 0 * * SAT,sun", "2025-01-04 00:00"},
+		{"0 0 1 mar-may *", "2025-03-01 00:00"},
+		{"0 0 29 feb *", "2028-02-29 00:00"},
+		// Either day matches if both are restricted.
+		{"0 0 15 * fri", "2025-01-03 00:00"},
+		// A star with a step doesn't restrict the day.
+		{"0 0 15 * */1", "2025-01-15 00:00"},
+		{"0 0 */1 * fri", "2025-01-03 00:00"},
+	} {
+		sched, err := parseCron(test.spec)
+		if err != nil {
+			t.Errorf("%q: %v", test.spec, err)
+			continue
+		}
+		if next := sched.next(from).Format("2006-01-02 15:04"); next != test.next {
+			t.Errorf("%q: expected %s, got %s", test.spec, test.next, next)
+		}
+	}
+}
+
+func TestWeekday(t *testing.T) {
+	if day := weekday("Tue"); day != time.Tuesday {
+		t.Errorf("expected Tuesday, got %v", day)
+	}
+	if day := weekday("tuesday"); day != -1 {
+		t.Errorf("expected -1, got %v", day)
+	}
+}
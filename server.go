@@ -0,0 +1,156 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Built-in Web server settings.
+type serverConfig struct {
+	Cert     string            // TLS certificate, PEM.
+	Key      string            // TLS private key, PEM.
+	ClientCA string            `yaml:"client_ca"` // Require client certificates signed by the CA.
+	Users    map[string]string // Basic auth: user name and bcrypt hash of the password.
+	Tokens   []string          // Bearer tokens.
+	CORS     []string          // Origins allowed to read the API, * for any.
+	tls      *tls.Config
+	verified sync.Map // Basic auth credentials that passed bcrypt.
+}
+
+// Web server settings, or nil for plain HTTP open to anyone.
+var httpd *serverConfig
+
+// Check the settings, load the certificates.
+func (conf *serverConfig) validate() error {
+	if (conf.Cert == "") != (conf.Key == "") {
+		return errors.New("server: cert and key should be set together")
+	}
+	if conf.ClientCA != "" && conf.Cert == "" {
+		return errors.New("server: client_ca requires cert and key")
+	}
+	for user, hash := range conf.Users {
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return errors.New("server: user " + user + ": not a bcrypt hash")
+		}
+	}
+	for _, token := range conf.Tokens {
+		if token == "" {
+			return errors.New("server: empty token")
+		}
+	}
+	if conf.Cert == "" {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
+	if err != nil {
+		return errors.New("server: " + err.Error())
+	}
+	conf.tls = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if conf.ClientCA != "" {
+		conf.tls.ClientCAs, err = loadCA(conf.ClientCA)
+		if err != nil {
+			return errors.New("server: " + err.Error())
+		}
+		conf.tls.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return nil
+}
+
+// TLS files, to tell if they are changed. They are applied only on start.
+func (conf *serverConfig) tlsFiles() string {
+	if conf == nil {
+		return ""
+	}
+	return conf.Cert + "\x00" + conf.Key + "\x00" + conf.ClientCA
+}
+
+// Check the credentials and set the CORS headers.
+func protect(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.RLock()
+		conf := httpd
+		mutex.RUnlock()
+		h := w.Header()
+		origin := conf.origin(r)
+		if conf != nil && origin != "*" { // Allowed or not, depending on the origin.
+			h.Add("Vary", "Origin")
+		}
+		if origin != "" {
+			h.Set("Access-Control-Allow-Origin", origin)
+			if origin != "*" {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+			// Preflight is sent without credentials. Without the settings the API is open
+			// to anyone, so other sites may only read it, not create or delete silences.
+			if conf != nil && r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				h.Set("Server", "jsonmon")
+				h.Set("Access-Control-Allow-Methods", "GET, POST, DELETE")
+				h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		if !conf.authorized(r) {
+			h.Set("Server", "jsonmon")
+			h.Set("WWW-Authenticate", `Basic realm="jsonmon"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Value for Access-Control-Allow-Origin, empty if the origin is not allowed.
+func (conf *serverConfig) origin(r *http.Request) string {
+	if conf == nil { // Open to anyone, as before the settings existed.
+		return "*"
+	}
+	origin := r.Header.Get("Origin")
+	for _, allowed := range conf.CORS {
+		if allowed == "*" {
+			return "*"
+		}
+		if origin != "" && strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// Tells if the request has a valid token or user name and password.
+func (conf *serverConfig) authorized(r *http.Request) bool {
+	if conf == nil || (len(conf.Users) == 0 && len(conf.Tokens) == 0) {
+		return true
+	}
+	auth := r.Header.Get("Authorization")
+	if token := strings.TrimPrefix(auth, "Bearer "); token != auth {
+		for _, valid := range conf.Tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(valid)) == 1 {
+				return true
+			}
+		}
+		return false
+	}
+	user, password, ok := r.BasicAuth()
+	hash, known := conf.Users[user]
+	if !ok || !known {
+		return false
+	}
+	// bcrypt is slow by design, so don't run it on every UI refresh.
+	key := sha256.Sum256([]byte(user + "\x00" + password))
+	if _, ok := conf.verified.Load(key); ok {
+		return true
+	}
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+		return false
+	}
+	conf.verified.Store(key, true)
+	return true
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCORS(t *testing.T) {
+	setup()
+	defer func() { httpd = nil }()
+	ok := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	for _, test := range []struct {
+		conf   *serverConfig
+		origin string
+		allow  string
+		vary   bool
+	}{
+		{nil, "https://other.example", "*", false},
+		{&serverConfig{CORS: []string{"*"}}, "https://other.example", "*", false},
+		{&serverConfig{CORS: []string{"https://ui.example"}}, "https://ui.example", "https://ui.example", true},
+		{&serverConfig{CORS: []string{"https://ui.example"}}, "https://other.example", "", true},
+		{&serverConfig{CORS: []string{"https://ui.example"}}, "", "", true},
+		{&serverConfig{}, "https://ui.example", "", true},
+	} {
+		httpd = test.conf
+		r := httptest.NewRequest(http.MethodGet, "/status", nil)
+		if test.origin != "" {
+			r.Header.Set("Origin", test.origin)
+		}
+		w := httptest.NewRecorder()
+		protect(ok).ServeHTTP(w, r)
+		if allow := w.Header().Get("Access-Control-Allow-Origin"); allow != test.allow {
+			t.Errorf("%v %q: expected origin %q, got %q", test.conf, test.origin, test.allow, allow)
+		}
+		if vary := w.Header().Get("Vary") == "Origin"; vary != test.vary {
+			t.Errorf("%v %q: expected Vary: Origin %v", test.conf, test.origin, test.vary)
+		}
+	}
+}
+
+// Without the settings, other sites can't change the silences.
+func TestOpenPreflight(t *testing.T) {
+	setup()
+	r := httptest.NewRequest(http.MethodOptions, "/silences", nil)
+	r.Header.Set("Origin", "https://other.example")
+	r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	w := httptest.NewRecorder()
+	protect(http.HandlerFunc(handleSilences)).ServeHTTP(w, r)
+	if methods := w.Header().Get("Access-Control-Allow-Methods"); methods != "" {
+		t.Errorf("expected no allowed methods, got %q", methods)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// How long to wait for the running checks and pending notifications on shutdown.
+const shutdownTimeout = 30 * time.Second
+
+// Parent context of all checks, cancelled on shutdown.
+var root, stopChecks = context.WithCancel(context.Background())
+
+// Running checks and notifications in flight.
+var workers sync.WaitGroup
+var pending sync.WaitGroup
+
+// Send the notification in background, shutdown waits for it.
+func deliver(send func()) {
+	pending.Add(1)
+	go func() {
+		defer pending.Done()
+		send()
+	}()
+}
+
+// Stop the checks and the Web server, let the notifications flush.
+func shutdown(server *http.Server) {
+	log(5, "Shutting down")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	stopChecks()
+	server.Shutdown(ctx)
+	if !wait(ctx, &workers) || !wait(ctx, &pending) {
+		log(3, "Shutdown timed out after "+shutdownTimeout.String()+", some notifications are not sent")
+	}
+}
+
+// Wait for the group until the context is done.
+func wait(ctx context.Context, group *sync.WaitGroup) bool {
+	done := make(chan struct{})
+	go func() {
+		group.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Selects checks by names, tags or by a regexp matching the name.
+type selector struct {
+	Checks []string `json:"checks,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	Match  string   `json:"match,omitempty"`
+	regex  *regexp.Regexp
+}
+
+// Silence mutes the checks' notifications until the given time.
+type silence struct {
+	ID string `json:"id"`
+	selector
+	Until   time.Time `json:"until"`
+	Comment string    `json:"comment,omitempty"`
+}
+
+// Recurring maintenance window, in local time.
+type window struct {
+	selector `yaml:",inline"`
+	Days     []string // Every day if not set.
+	From     string   // HH:MM
+	To       string   // HH:MM, could be the next day.
+	days     map[time.Weekday]bool
+	from     int // Minutes since midnight.
+	to       int
+}
+
+// Silences created with the API and maintenance windows from the config.
+var silences = []*silence{}
+var maintenance []*window
+
+// Last modified date of the silences list for HTTP caching.
+var modSilences string
+
+// Check the selector and compile its regexp.
+func (sel *selector) validate() (err error) {
+	if len(sel.Checks) == 0 && len(sel.Tags) == 0 && sel.Match == "" {
+		return errors.New("no checks, tags or match to select")
+	}
+	if sel.Match != "" {
+		sel.regex, err = regexp.Compile(sel.Match)
+	}
+	return
+}
+
+// Tells if the check is selected.
+func (sel *selector) selects(check *Check) bool {
+	name := check.title()
+	for _, selected := range sel.Checks {
+		if selected == name {
+			return true
+		}
+	}
+	for _, tag := range sel.Tags {
+		if check.tagged([]string{tag}) {
+			return true
+		}
+	}
+	return sel.regex != nil && sel.regex.MatchString(name)
+}
+
+// Check the window and parse its days and times.
+func (win *window) validate() (err error) {
+	if err = win.selector.validate(); err != nil {
+		return
+	}
+	win.days = make(map[time.Weekday]bool)
+	for _, day := range win.Days {
+		d := weekday(day)
+		if d < 0 {
+			return errors.New("unknown day " + day)
+		}
+		win.days[d] = true
+	}
+	if win.from, err = minutes(win.From); err != nil {
+		return
+	}
+	win.to, err = minutes(win.To)
+	return
+}
+
+// Parse HH:MM into minutes since midnight.
+func minutes(clock string) (int, error) {
+	ts, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, errors.New("invalid time " + clock + ", should be HH:MM")
+	}
+	return ts.Hour()*60 + ts.Minute(), nil
+}
+
+// Tells if the window is active at the moment.
+func (win *window) active(ts time.Time) bool {
+	now := ts.Hour()*60 + ts.Minute()
+	if win.from <= win.to { // Within one day.
+		return now >= win.from && now < win.to && win.onDay(ts.Weekday())
+	}
+	if now >= win.from { // Crosses midnight: started today or yesterday.
+		return win.onDay(ts.Weekday())
+	}
+	return now < win.to && win.onDay((ts.Weekday()+6)%7)
+}
+
+func (win *window) onDay(day time.Weekday) bool {
+	return len(win.days) == 0 || win.days[day]
+}
+
+// Update the check's silenced flag and return it. Call with the mutex locked.
+func (check *Check) silence(ts time.Time) bool {
+	silenced := false
+	for _, entry := range silences {
+		if ts.Before(entry.Until) && entry.selects(check) {
+			silenced = true
+		}
+	}
+	for _, win := range maintenance {
+		if win.active(ts) && win.selects(check) {
+			silenced = true
+		}
+	}
+	if silenced != check.Silenced {
+		check.Silenced = silenced
+		check.changed(ts)
+	}
+	return silenced
+}
+
+// Update all checks' silenced flags, dropping expired silences.
+func refreshSilences() {
+	ts := time.Now()
+	mutex.Lock()
+	active := silences[:0]
+	for _, entry := range silences {
+		if ts.Before(entry.Until) {
+			active = append(active, entry)
+		}
+	}
+	if len(active) != len(silences) {
+		modSilences = etag(ts)
+	}
+	silences = active
+	for _, check := range checks {
+		check.silence(ts)
+	}
+	mutex.Unlock()
+}
+
+// Silences API: list, create and delete.
+func handleSilences(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/silences")
+	id = strings.TrimPrefix(id, "/")
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		refreshSilences()
+		displayJSON(w, r, &silences, &modSilences, true)
+	case r.Method == http.MethodPost && id == "":
+		postSilence(w, r)
+	case r.Method == http.MethodDelete && id != "":
+		deleteSilence(w, r, id)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// Create a silence from the JSON request.
+func postSilence(w http.ResponseWriter, r *http.Request) {
+	// Forms and other simple cross-site requests can't send JSON.
+	if media, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); media != "application/json" {
+		http.Error(w, "Content-Type should be application/json", http.StatusUnsupportedMediaType)
+		return
+	}
+	var entry silence
+	err := json.NewDecoder(r.Body).Decode(&entry)
+	if err == nil {
+		err = entry.validate()
+	}
+	if err == nil && !entry.Until.After(time.Now()) {
+		err = errors.New("until should be in the future")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id := make([]byte, 8)
+	rand.Read(id)
+	entry.ID = hex.EncodeToString(id)
+	ts := time.Now()
+	mutex.Lock()
+	silences = append(silences, &entry)
+	modSilences = etag(ts)
+	mutex.Unlock()
+	refreshSilences()
+	log(5, "Silenced until "+entry.Until.Format(time.RFC3339)+": "+entry.describe())
+	cache := etag(ts)
+	displayJSON(w, r, &entry, &cache, false)
+}
+
+// Delete the silence by ID.
+func deleteSilence(w http.ResponseWriter, r *http.Request, id string) {
+	var deleted *silence
+	mutex.Lock()
+	for i, entry := range silences {
+		if entry.ID == id {
+			deleted = entry
+			silences = append(silences[:i], silences[i+1:]...)
+			modSilences = etag(time.Now())
+			break
+		}
+	}
+	mutex.Unlock()
+	if deleted == nil {
+		http.NotFound(w, r)
+		return
+	}
+	refreshSilences()
+	log(5, "Silence deleted: "+deleted.describe())
+	w.Header().Set("Server", "jsonmon")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Describe the silence for the log.
+func (entry *silence) describe() string {
+	var what []string
+	if len(entry.Checks) != 0 {
+		what = append(what, "checks "+strings.Join(entry.Checks, ", "))
+	}
+	if len(entry.Tags) != 0 {
+		what = append(what, "tags "+strings.Join(entry.Tags, ", "))
+	}
+	if entry.Match != "" {
+		what = append(what, "match "+strconv.Quote(entry.Match))
+	}
+	if entry.Comment != "" {
+		what = append(what, "("+entry.Comment+")")
+	}
+	return strings.Join(what, " ")
+}
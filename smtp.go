@@ -0,0 +1,121 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// How long to wait for the SMTP server to accept connection, and then for the whole session.
+const smtpTimeout = 30 * time.Second
+
+// Built-in SMTP client settings.
+type smtpConfig struct {
+	Host     string
+	Port     int
+	TLS      string // Empty for plain SMTP, starttls or implicit.
+	Username string
+	Password string
+	From     string
+}
+
+// SMTP settings, or nil to use sendmail.
+var mail *smtpConfig
+
+// Check the settings and fill in the defaults.
+func (conf *smtpConfig) validate() error {
+	if conf.Host == "" {
+		return errors.New("smtp: host is not set")
+	}
+	if conf.From == "" {
+		return errors.New("smtp: from is not set")
+	}
+	switch conf.TLS {
+	case "", "starttls":
+		if conf.Port == 0 {
+			conf.Port = 25
+		}
+	case "implicit":
+		if conf.Port == 0 {
+			conf.Port = 465
+		}
+	default:
+		return errors.New("smtp: tls should be starttls or implicit, not " + conf.TLS)
+	}
+	// Go's SMTP client sends the password only over TLS or to localhost.
+	if conf.Username != "" && conf.TLS == "" && !isLocalhost(conf.Host) {
+		return errors.New("smtp: username requires tls to be starttls or implicit")
+	}
+	if conf.Port < 0 || conf.Port > 65535 {
+		return errors.New("smtp: invalid port " + strconv.Itoa(conf.Port))
+	}
+	return nil
+}
+
+func isLocalhost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// Send the message to comma-separated recipients.
+func (conf *smtpConfig) send(to string, msg string) error {
+	addr := net.JoinHostPort(conf.Host, strconv.Itoa(conf.Port))
+	dialer := &net.Dialer{Timeout: smtpTimeout}
+	var conn net.Conn
+	var err error
+	if conf.TLS == "implicit" {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: conf.Host})
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	// The whole session, so a stalled server doesn't block the notifications.
+	conn.SetDeadline(time.Now().Add(smtpTimeout))
+	client, err := smtp.NewClient(conn, conf.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+	if hostname, err := os.Hostname(); err == nil {
+		if err = client.Hello(hostname); err != nil {
+			return err
+		}
+	}
+	if conf.TLS == "starttls" {
+		if err = client.StartTLS(&tls.Config{ServerName: conf.Host}); err != nil {
+			return err
+		}
+	}
+	if conf.Username != "" {
+		err = client.Auth(smtp.PlainAuth("", conf.Username, conf.Password, conf.Host))
+		if err != nil {
+			return err
+		}
+	}
+	if err = client.Mail(conf.From); err != nil {
+		return err
+	}
+	for _, rcpt := range strings.Split(to, ",") {
+		if err = client.Rcpt(strings.TrimSpace(rcpt)); err != nil {
+			return err
+		}
+	}
+	data, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = data.Write([]byte(msg)); err != nil {
+		return err
+	}
+	if err = data.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Check's state saved between restarts.
+type state struct {
+	Name   string `json:"name,omitempty"`
+	Target string `json:"target"`
+	Failed bool   `json:"failed"`
+	Since  string `json:"since,omitempty"`
+}
+
+// Serializes state file writes.
+var stateMutex sync.Mutex
+
+// Stable identity to match saved state: name plus target.
+func (check *Check) identity() string {
+	return check.Name + "\x00" + check.target()
+}
+
+// Restore checks' state saved by the previous run.
+func loadState(file string) error {
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) { // First run.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var list []state
+	err = json.Unmarshal(data, &list)
+	if err != nil {
+		return err
+	}
+	saved := make(map[string]state, len(list))
+	for _, entry := range list {
+		saved[entry.Name+"\x00"+entry.Target] = entry
+	}
+	mutex.Lock()
+	for _, check := range checks {
+		if entry, ok := saved[check.identity()]; ok {
+			check.Failed = entry.Failed
+			check.Since = entry.Since
+		}
+	}
+	mutex.Unlock()
+	return nil
+}
+
+// Atomically write checks' state, if the state file is set.
+func saveState() {
+	if *stateFile == "" {
+		return
+	}
+	stateMutex.Lock()
+	defer stateMutex.Unlock()
+	mutex.RLock()
+	list := make([]state, 0, len(checks))
+	for _, check := range checks {
+		list = append(list, state{
+			Name:   check.Name,
+			Target: check.target(),
+			Failed: check.Failed,
+			Since:  check.Since,
+		})
+	}
+	mutex.RUnlock()
+	data, _ := json.Marshal(list)
+	tmp, err := os.CreateTemp(filepath.Dir(*stateFile), ".jsonmon-state-*")
+	if err != nil {
+		log(3, "Failed to save state: "+err.Error())
+		return
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), *stateFile)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		log(3, "Failed to save state: "+err.Error())
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// How long to wait for the TCP handshake if no timeout is set.
+const dialTimeout = 10 * time.Second
+
+// TCP worker.
+func (check *Check) tcp(ctx context.Context, sleep *time.Duration) ([]byte, time.Duration, error) {
+	// Connect in N attempts.
+	took, err := check.try(ctx, sleep, func() error {
+		return check.dial(ctx)
+	})
+	return nil, took, err
+}
+
+// The actual TCP connect.
+func (check *Check) dial(ctx context.Context) error {
+	timeout := dialTimeout
+	if check.Timeout > 0 {
+		timeout = time.Second * time.Duration(check.Timeout)
+	}
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", check.TCP)
+	if err != nil {
+		return dialError(check.TCP, err, timeout)
+	}
+	conn.Close()
+	return nil
+}
+
+// Describe why the connection failed: refused, timed out or not resolved.
+func dialError(addr string, err error, timeout time.Duration) error {
+	var dnsErr *net.DNSError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &dnsErr):
+		return errors.New(addr + ": failed to resolve " + dnsErr.Name + ": " + dnsErr.Err)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return errors.New(addr + ": connection refused")
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return errors.New(addr + ": timed out after " + strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64) + "s")
+	}
+	return errors.New(addr + ": " + err.Error())
+}
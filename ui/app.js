@@ -12,20 +12,96 @@ function getJson($rootScope, $scope, $http) {
     .then(function(res){
       if (!angular.equals($scope.json, res.data)) {
         $scope.json = res.data;
-        // Page title should include errors number.
-        var errors = res.data.filter(function(check) {
-          return check.failed;
+        $scope.groups = group(res.data);
+        // Page title should include errors number, per group if grouped.
+        var errors = $scope.groups.filter(function(group) {
+          return group.failed;
+        }).map(function(group) {
+          return group.name ? group.name + ': ' + group.failed : group.failed;
         });
         if (errors.length) {
-          $rootScope.title = '(' + errors.length + ') ' + Title;
+          $rootScope.title = '(' + errors.join(', ') + ') ' + Title;
         } else {
           $rootScope.title = Title;
         }
+        // Keep the opened details up to date.
+        Object.keys($scope.details).forEach(function(id) {
+          getDetail($scope, $http, id);
+        });
+      }
+    });
+}
+
+// Check's last failure: error, output, attempts.
+function getDetail($scope, $http, id) {
+  $http.get('/status/' + id)
+    .then(function(res){
+      if (id in $scope.details) {
+        $scope.details[id] = res.data.last_error || res.data.last;
       }
+    }, function() {
+      delete $scope.details[id];
     });
 }
 
+// Split checks into groups, keeping the config order.
+function group(checks) {
+  var groups = [],
+      index  = {};
+  checks.forEach(function(check) {
+    var name = check.group || '';
+    if (!(name in index)) {
+      index[name] = {name: name, checks: [], failed: 0};
+      groups.push(index[name]);
+    }
+    index[name].checks.push(check);
+    if (check.failed) {
+      index[name].failed++;
+    }
+  });
+  return groups;
+}
+
+// Check's target: URL, command, etc.
+function target(check) {
+  return check.web || check.shell || check.tcp || check.dns || check.ping;
+}
+
+// Check's display state.
+function state(check) {
+  if (check.flapping) {
+    return 'flapping';
+  }
+  if (check.unreachable) {
+    return 'unreachable';
+  }
+  if (check.failed) {
+    return 'fail';
+  }
+  // Failing, but not enough times in a row yet.
+  if (check.consecutive) {
+    return 'pending';
+  }
+  return 'ok';
+}
+
 App.controller('reload', function($rootScope, $scope, $http) {
+  $scope.state = state;
+  $scope.target = target;
+  $scope.collapsed = {};
+  $scope.toggle = function(name) {
+    $scope.collapsed[name] = !$scope.collapsed[name];
+  };
+  $scope.details = {};
+  // Show or hide the failed check's detail.
+  $scope.inspect = function(check) {
+    if (check.id in $scope.details) {
+      delete $scope.details[check.id];
+    } else if (state(check) !== 'ok') {
+      $scope.details[check.id] = null;
+      getDetail($scope, $http, check.id);
+    }
+  };
   getJson($rootScope, $scope, $http);
   setInterval(function() {
     getJson($rootScope, $scope, $http);
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log/syslog"
 	"os"
+	"os/exec"
+	"syscall"
 )
 
 // ShellPath points to a Bourne-compatible shell.
@@ -37,3 +39,13 @@ func log(severity int, message string) {
 		}
 	}
 }
+
+// Run the command in its own process group.
+func setGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// Kill the command with all of its children.
+func killGroup(cmd *exec.Cmd) {
+	syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
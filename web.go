@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -44,9 +45,40 @@ func displayUI(w http.ResponseWriter, r *http.Request, mime string, name string,
 	}
 }
 
-// Display checks' details.
+// Display checks' details, filtered with ?tag=...&group=... if set.
 func getChecks(w http.ResponseWriter, r *http.Request) {
-	displayJSON(w, r, &checks, &modified, true)
+	query := r.URL.Query()
+	tags := query["tag"]
+	group, grouped := query["group"]
+	if len(tags) == 0 && !grouped {
+		displayJSON(w, r, &checks, &modified, true)
+		return
+	}
+	list := []*Check{}
+	mutex.RLock()
+	for _, check := range checks {
+		if (!grouped || check.Group == group[0]) && check.tagged(tags) {
+			list = append(list, check)
+		}
+	}
+	mutex.RUnlock()
+	displayJSON(w, r, &list, &modified, true)
+}
+
+// Display the check with its last run and last failure, /status/{id}.
+func getCheck(w http.ResponseWriter, r *http.Request) {
+	check := findCheck(strings.TrimPrefix(r.URL.Path, "/status/"))
+	if check == nil {
+		http.NotFound(w, r)
+		return
+	}
+	// Pointers to the fields, so they are read under the lock.
+	status := struct {
+		*Check
+		Last      **detail `json:"last"`
+		LastError **detail `json:"last_error"`
+	}{check, &check.last, &check.lastError}
+	displayJSON(w, r, &status, &check.updated, true)
 }
 
 // Display application version.
@@ -63,7 +95,7 @@ func displayJSON(w http.ResponseWriter, r *http.Request, data interface{}, cache
 	if lock {
 		mutex.RLock()
 	}
-	if r.Header.Get("If-None-Match") == *cache {
+	if etag := r.Header.Get("If-None-Match"); etag != "" && etag == *cache {
 		cached = true
 	} else {
 		h.Set("ETag", *cache)
@@ -76,7 +108,6 @@ func displayJSON(w http.ResponseWriter, r *http.Request, data interface{}, cache
 		w.WriteHeader(http.StatusNotModified)
 	} else {
 		h.Set("Cache-Control", "no-cache")
-		h.Set("Access-Control-Allow-Origin", "*")
 		h.Set("X-Content-Type-Options", "nosniff")
 		h.Set("Content-Type", "application/json; charset=utf-8")
 		w.Write(result)
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// Globals set up by main.
+func setup() {
+	if mutex == nil {
+		mutex = &sync.RWMutex{}
+	}
+	if useSyslog == nil {
+		useSyslog = new(bool)
+	}
+}
+
+// Load the config the way main does, disabling the invalid entries.
+func loadChecks(t *testing.T, yaml string) []*Check {
+	setup()
+	file := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(file, []byte(yaml), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	conf, err := loadConfig(file, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, check := range conf.Checks {
+		if err := check.prepare(); err != nil {
+			check.disable(err)
+		}
+	}
+	checks = conf.Checks
+	return checks
+}
+
+// Disabled checks are served too, they need the ETag.
+func TestETag(t *testing.T) {
+	list := loadChecks(t, "- shell: 'true'\n- shell: 'false'\n  tries: -1\n")
+	for _, check := range list {
+		for url, handler := range map[string]http.HandlerFunc{
+			"/status/" + check.ID:        getCheck,
+			"/history?check=" + check.ID: getHistory,
+		} {
+			w := httptest.NewRecorder()
+			handler(w, httptest.NewRequest(http.MethodGet, url, nil))
+			etag := w.Header().Get("ETag")
+			if w.Code != http.StatusOK || etag == "" {
+				t.Errorf("%s %s: expected 200 with an ETag, got %d %q", check.Shell, url, w.Code, etag)
+				continue
+			}
+			r := httptest.NewRequest(http.MethodGet, url, nil)
+			r.Header.Set("If-None-Match", etag)
+			w = httptest.NewRecorder()
+			handler(w, r)
+			if w.Code != http.StatusNotModified {
+				t.Errorf("%s %s: expected 304, got %d", check.Shell, url, w.Code)
+			}
+		}
+	}
+}
+
+func TestEmptyETag(t *testing.T) {
+	var empty string
+	w := httptest.NewRecorder()
+	displayJSON(w, httptest.NewRequest(http.MethodGet, "/version", nil), &version, &empty, false)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+}
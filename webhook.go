@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default webhook delivery attempts and the first delay, doubled each retry.
+const webhookTries = 3
+const webhookRetry = 5 * time.Second
+
+var webhookClient = &http.Client{Timeout: 30 * time.Second}
+
+// Webhook notification target. Could be set with just the URL.
+type webhook struct {
+	URL     string
+	Method  string
+	Headers map[string]string
+	Tries   int
+}
+
+// JSON document sent to the webhook.
+type event struct {
+	Event   string `json:"event"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Target  string `json:"target"`
+	Failed  bool   `json:"failed"`
+	Since   string `json:"since"`
+	Message string `json:"message,omitempty"`
+	Version string `json:"jsonmon"`
+}
+
+func (hook *webhook) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if unmarshal(&hook.URL) == nil {
+		return nil
+	}
+	type plain webhook
+	return unmarshal((*plain)(hook))
+}
+
+// Check the settings and fill in the defaults.
+func (hook *webhook) validate() error {
+	link, err := url.Parse(hook.URL)
+	if err != nil {
+		return err
+	}
+	if (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+		return errors.New("webhook: not an HTTP or HTTPS URL: " + hook.URL)
+	}
+	if hook.Method == "" {
+		hook.Method = http.MethodPost
+	}
+	if hook.Tries < 0 {
+		return errors.New("webhook: tries can't be negative")
+	}
+	if hook.Tries == 0 {
+		hook.Tries = webhookTries
+	}
+	return nil
+}
+
+// Describe the check's event for the webhook: failed, fixed, flapping or stable.
+func (check *Check) event(kind string, name *string, msg *string) *event {
+	data := &event{
+		Event:   strings.ToLower(kind),
+		Name:    *name,
+		Type:    check.kind(),
+		Target:  check.target(),
+		Failed:  check.Failed,
+		Since:   check.Since,
+		Version: Version,
+	}
+	if msg != nil {
+		data.Message = *msg
+	}
+	return data
+}
+
+// Deliver the event, retrying with backoff on network errors and 5xx.
+func (hook *webhook) send(data *event) {
+	body, _ := json.Marshal(data)
+	delay := webhookRetry
+	for i := 1; ; i++ {
+		retry, err := hook.post(body)
+		if err == nil {
+			return
+		}
+		log(3, "Webhook "+hook.URL+" failed, attempt "+strconv.Itoa(i)+" of "+
+			strconv.Itoa(hook.Tries)+"\n"+err.Error())
+		if !retry || i >= hook.Tries {
+			return
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// Single delivery attempt. Tells if it's worth retrying.
+func (hook *webhook) post(body []byte) (bool, error) {
+	req, err := http.NewRequest(hook.Method, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "jsonmon/"+Version)
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 500 {
+		return true, errors.New(hook.URL + " returned " + strconv.Itoa(resp.StatusCode))
+	}
+	if resp.StatusCode >= 400 {
+		return false, errors.New(hook.URL + " returned " + strconv.Itoa(resp.StatusCode))
+	}
+	return false, nil
+}
@@ -5,6 +5,8 @@ package main
 import (
 	"fmt"
 	"os"
+	"os/exec"
+	"strconv"
 
 	"golang.org/x/sys/windows/svc/eventlog"
 )
@@ -38,3 +40,15 @@ func log(severity int, message string) {
 		}
 	}
 }
+
+// Processes are killed as a tree on Windows, see killGroup.
+func setGroup(cmd *exec.Cmd) {
+}
+
+// Kill the command with all of its children.
+func killGroup(cmd *exec.Cmd) {
+	err := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+	if err != nil {
+		cmd.Process.Kill()
+	}
+}